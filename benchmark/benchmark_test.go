@@ -2,10 +2,13 @@ package benchmark
 
 import (
 	"math/rand/v2"
+	"sync"
 	"testing"
 
 	"github.com/goark/mt/v2"
+	"github.com/goark/mt/v2/dsfmt"
 	"github.com/goark/mt/v2/mt19937"
+	"github.com/goark/mt/v2/sfmt"
 )
 
 var seed1, seed2, seed3 = rand.Uint64(), rand.Uint64(), rand.Int64()
@@ -26,6 +29,80 @@ func BenchmarkRandomMT19917(b *testing.B) {
 	}
 }
 
+func BenchmarkRandomSFMT19937(b *testing.B) {
+	rnd := sfmt.New(seed3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = rnd.Uint64()
+	}
+}
+
+func BenchmarkFillMT19917(b *testing.B) {
+	rnd := mt19937.New(seed3)
+	buf := make([]uint64, 1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range buf {
+			buf[j] = rnd.Uint64()
+		}
+	}
+}
+
+func BenchmarkFillMT19917Bulk(b *testing.B) {
+	rnd := mt19937.New(seed3)
+	buf := make([]uint64, 1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rnd.FillUint64s(buf)
+	}
+}
+
+func BenchmarkFillMT19917Locked(b *testing.B) {
+	rnd := mt.New(mt19937.New(seed3))
+	buf := make([]uint64, 1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range buf {
+			buf[j] = rnd.Uint64()
+		}
+	}
+}
+
+func BenchmarkFillMT19917LockedBulk(b *testing.B) {
+	rnd := mt.New(mt19937.New(seed3))
+	buf := make([]uint64, 1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rnd.FillUint64s(buf)
+	}
+}
+
+func BenchmarkFillSFMT19937(b *testing.B) {
+	rnd := sfmt.New(seed3)
+	buf := make([]uint64, 1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rnd.FillUint64s(buf)
+	}
+}
+
+func BenchmarkRandomDSFMT19937Float64(b *testing.B) {
+	rnd := dsfmt.New(seed3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = rnd.Float64(mt.ClosedOpen)
+	}
+}
+
+func BenchmarkFillDSFMT19937Float64(b *testing.B) {
+	rnd := dsfmt.New(seed3)
+	buf := make([]float64, 1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rnd.FillFloat64s(buf, mt.ClosedOpen)
+	}
+}
+
 func BenchmarkRandomPCGRand(b *testing.B) {
 	rnd := rand.New(rand.NewPCG(seed1, seed2))
 	b.ResetTimer()
@@ -57,6 +134,125 @@ func BenchmarkRandomMT19917Locked(b *testing.B) {
 	}
 }
 
+func BenchmarkRandomMT19917LockedGeneric(b *testing.B) {
+	rnd := mt.NewLocked(mt19937.New(seed3))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = rnd.Uint64()
+	}
+}
+
+func BenchmarkRandomGlobalParallel(b *testing.B) {
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = rand.Uint64() // per-thread runtime state, without lock
+		}
+	})
+}
+
+func BenchmarkRandomChaCha8MutexParallel(b *testing.B) {
+	var seed [32]byte
+	rnd := rand.NewChaCha8(seed)
+	mutex := sync.Mutex{}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			mutex.Lock()
+			_ = rnd.Uint64()
+			mutex.Unlock()
+		}
+	})
+}
+
+func BenchmarkRandomMT19917LockedParallel(b *testing.B) {
+	rnd := mt.New(mt19937.New(seed3))
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = rnd.Uint64()
+		}
+	})
+}
+
+func BenchmarkRandomMT19917LockedGenericParallel(b *testing.B) {
+	rnd := mt.NewLocked(mt19937.New(seed3))
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = rnd.Uint64()
+		}
+	})
+}
+
+func BenchmarkRandomMT19917ShardedParallel(b *testing.B) {
+	rnd := mt19937.NewSharded(seed3, 0)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = rnd.Uint64()
+		}
+	})
+}
+
+func BenchmarkRandomMT19917LockedIntN(b *testing.B) {
+	rnd := mt.New(mt19937.New(seed3))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = rnd.IntN(1000)
+	}
+}
+
+func BenchmarkRandomMT19917LockedRandIntN(b *testing.B) {
+	rnd := rand.New(mt.New(mt19937.New(seed3)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = rnd.IntN(1000)
+	}
+}
+
+func BenchmarkRandomMT19917Float32(b *testing.B) {
+	rnd := mt19937.New(seed3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = rnd.Float32(mt.ClosedOpen)
+	}
+}
+
+func BenchmarkRandomMT19917Float64(b *testing.B) {
+	rnd := mt19937.New(seed3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = rnd.Float64(mt.ClosedOpen)
+	}
+}
+
+func BenchmarkRandomMT19917Float64Full(b *testing.B) {
+	rnd := mt19937.New(seed3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = rnd.Float64Full()
+	}
+}
+
+func BenchmarkReaderMT19917(b *testing.B) {
+	r := mt.New(mt19937.New(seed3)).NewReader()
+	buf := [8]byte{}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = r.Read(buf[:])
+	}
+}
+
+func BenchmarkReaderMT19917Unbuffered(b *testing.B) {
+	r := mt.New(mt19937.New(seed3)).NewReaderSize(8)
+	buf := [8]byte{}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = r.Read(buf[:])
+	}
+}
+
 /* MIT License
  *
  * Copyright 2019-2024 Spiegel
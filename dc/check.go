@@ -0,0 +1,98 @@
+package dc
+
+import (
+	"github.com/goark/mt/v2/mt19937ar"
+)
+
+// checker checks the period of MT by the inversive-decimation method (check32.c).
+type checker struct {
+	m, n, r, w int
+	upperMask  uint32 //most significant w-r bits
+	lowerMask  uint32 //least significant r bits
+	wordMask   uint32 //least significant w bits
+}
+
+// newChecker returns checker (_InitCheck32_dc function).
+func newChecker(m, n, r, w int) *checker {
+	wordMask := uint32(0xffffffff) >> (32 - w)
+	lowerMask := uint32(1)<<r - 1
+	return &checker{
+		m: m, n: n, r: r, w: w,
+		upperMask: ^lowerMask & wordMask,
+		lowerMask: lowerMask,
+		wordMask:  wordMask,
+	}
+}
+
+// isIrreducible returns true if the characteristic polynomial with a is irreducible,
+// i.e. the period is 2^p-1 (_CheckPeriod_dc function).
+// The initial state is taken from org.
+func (ck *checker) isIrreducible(org *mt19937ar.Source, a uint32) bool {
+	m, n := ck.m, ck.n
+	p := n*ck.w - ck.r
+	x := make([]uint32, 2*p)
+	init := make([]uint32, n)
+	for i := 0; i < n; i++ {
+		x[i] = ck.wordMask & org.Uint32()
+		init[i] = x[i]
+	}
+	// it is better that LSBs of x[2] and x[3] are different
+	if x[2]&1 == x[3]&1 {
+		x[3] ^= 1
+		init[3] ^= 1
+	}
+
+	mat := [2]uint32{0, a}
+	for j := 0; j < p; j++ {
+		// generate
+		for i := 0; i < 2*p-n; i++ {
+			y := (x[i] & ck.upperMask) | (x[i+1] & ck.lowerMask)
+			x[i+n] = x[i+m] ^ (y >> 1) ^ mat[y&1]
+		}
+		// pick up odd subscript elements
+		for i := 2; i <= p; i++ {
+			x[i] = x[(i<<1)-1]
+		}
+		// reverse generate
+		for i := p - n; i >= 0; i-- {
+			y := x[i+n] ^ x[i+m] ^ mat[x[i+1]&1]
+			y = y<<1 | x[i+1]&1
+			x[i+1] = (x[i+1] & ck.upperMask) | (y & ck.lowerMask)
+			x[i] = (y & ck.upperMask) | (x[i] & ck.lowerMask)
+		}
+	}
+
+	if x[0]&ck.upperMask != init[0]&ck.upperMask {
+		return false
+	}
+	for i := 1; i < n; i++ {
+		if x[i] != init[i] {
+			return false
+		}
+	}
+	return true
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel, fork from Dynamic Creator of Mersenne Twister "dcmt".
+ * (http://www.math.sci.hiroshima-u.ac.jp/m-mat/MT/DC/dc.html)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
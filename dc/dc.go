@@ -0,0 +1,173 @@
+package dc
+
+import (
+	"errors"
+
+	"github.com/goark/mt/v2/mt19937ar"
+)
+
+const (
+	maxSearch = 10000 //maximum number of candidates of twist matrix A (MAX_SEARCH)
+	idSize    = 16    //number of bits of ID embedded in A (DEFAULT_ID_SIZE)
+	// MaxID is the maximum generator ID.
+	MaxID = 1<<idSize - 1
+)
+
+var (
+	// ErrInvalidWordSize is returned when word size is not 31 or 32.
+	ErrInvalidWordSize = errors.New("word size must be 31 or 32")
+	// ErrInvalidExponent is returned when exponent is not a supported Mersenne exponent.
+	ErrInvalidExponent = errors.New("unsupported Mersenne exponent")
+	// ErrInvalidID is returned when generator ID is out of range.
+	ErrInvalidID = errors.New("invalid generator ID")
+	// ErrNotFound is returned when no parameter set is found.
+	ErrNotFound = errors.New("parameter set not found")
+)
+
+// Exponents is a list of Mersenne exponents supported by Dynamic Creator.
+var Exponents = []int{521, 607, 1279, 2203, 2281, 3217, 4253, 4423, 9689, 9941, 11213, 19937, 21701, 23209, 44497}
+
+// Params is a parameter set of Mersenne Twister generated by Dynamic Creator (mt_struct).
+// The period of the generator is 2^P-1.
+type Params struct {
+	W, P           int    //word size (31 or 32) and Mersenne exponent
+	N, M, R        int    //degree of recursion, middle term and number of bits of lower mask (N*W-R == P)
+	A              uint32 //last row of twist matrix A
+	Shift0, Shift1 int    //tempering shifts (right)
+	ShiftB, ShiftC int    //tempering shifts (left)
+	MaskB, MaskC   uint32 //tempering masks
+}
+
+// Search searches a parameter set of Mersenne Twister for word size w (31 or 32) and Mersenne exponent p
+// (get_mt_parameter_st function). seed is a seed of the search; only the lower 32 bits are used.
+func Search(w, p int, seed int64) (*Params, error) {
+	sr, err := newSearcher(w, p, seed)
+	if err != nil {
+		return nil, err
+	}
+	return sr.search(0, 0)
+}
+
+// SearchID searches a parameter set of Mersenne Twister for word size w (31 or 32), Mersenne exponent p
+// and generator ID (get_mt_parameter_id_st function).
+// The ID (0 to MaxID) is embedded in the lower 16 bits of A, so that parameter sets with different IDs
+// are distinct and their generators are mathematically independent.
+func SearchID(w, p, id int, seed int64) (*Params, error) {
+	if id < 0 || id > MaxID {
+		return nil, ErrInvalidID
+	}
+	sr, err := newSearcher(w, p, seed)
+	if err != nil {
+		return nil, err
+	}
+	return sr.search(id, idSize)
+}
+
+// SearchIDs searches parameter sets of Mersenne Twister for generator IDs from startID to maxID
+// (get_mt_parameters_st function). If the search fails, it returns found parameter sets and ErrNotFound.
+func SearchIDs(w, p, startID, maxID int, seed int64) ([]*Params, error) {
+	if startID < 0 || maxID > MaxID || startID > maxID {
+		return nil, ErrInvalidID
+	}
+	sr, err := newSearcher(w, p, seed)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*Params, 0, maxID-startID+1)
+	for id := startID; id <= maxID; id++ {
+		params, err := sr.search(id, idSize)
+		if err != nil {
+			return list, err
+		}
+		list = append(list, params)
+	}
+	return list, nil
+}
+
+// searcher is a context of search (init_mt_search function).
+type searcher struct {
+	params Params
+	org    *mt19937ar.Source //generator of candidates (org_state)
+	pre    *prescreener
+	ck     *checker
+}
+
+func newSearcher(w, p int, seed int64) (*searcher, error) {
+	if w != 31 && w != 32 {
+		return nil, ErrInvalidWordSize
+	}
+	if !properExponent(p) {
+		return nil, ErrInvalidExponent
+	}
+	n := p/w + 1 // w never divides p
+	m := n / 2
+	if m < 2 {
+		m = n - 1
+	}
+	r := n*w - p
+	return &searcher{
+		params: Params{W: w, P: p, N: n, M: m, R: r},
+		org:    mt19937ar.New(seed),
+		pre:    newPrescreener(m, n, r, w),
+		ck:     newChecker(m, n, r, w),
+	}, nil
+}
+
+func properExponent(p int) bool {
+	for _, e := range Exponents {
+		if e == p {
+			return true
+		}
+	}
+	return false
+}
+
+// search searches A whose characteristic polynomial is primitive (get_irred_param function),
+// and then tempering parameters.
+func (sr *searcher) search(id, idw int) (*Params, error) {
+	for i := 0; i < maxSearch; i++ {
+		a := sr.nextA(id, idw)
+		if sr.pre.isReducible(a) {
+			continue
+		}
+		if sr.ck.isIrreducible(sr.org, a) {
+			params := sr.params
+			params.A = a
+			params.setTempering()
+			return &params, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// nextA returns a candidate of A (nextA and nextA_id functions).
+// The most significant bit is always 1, and id is embedded in the lower idw bits.
+func (sr *searcher) nextA(id, idw int) uint32 {
+	w := sr.params.W
+	mask := uint32(0xffffffff) >> (32 - w) >> idw << idw
+	return sr.org.Uint32()&mask | 1<<(w-1) | uint32(id)
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel, fork from Dynamic Creator of Mersenne Twister "dcmt".
+ * (http://www.math.sci.hiroshima-u.ac.jp/m-mat/MT/DC/dc.html)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
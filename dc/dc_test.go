@@ -0,0 +1,212 @@
+package dc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/goark/mt/v2/gf2"
+	"github.com/goark/mt/v2/mt19937ar"
+)
+
+func TestSearchError(t *testing.T) {
+	testCases := []struct {
+		w, p, id int
+		err      error
+	}{
+		{w: 64, p: 521, id: 0, err: ErrInvalidWordSize},
+		{w: 30, p: 521, id: 0, err: ErrInvalidWordSize},
+		{w: 32, p: 520, id: 0, err: ErrInvalidExponent},
+		{w: 32, p: 89, id: 0, err: ErrInvalidExponent},
+		{w: 32, p: 521, id: -1, err: ErrInvalidID},
+		{w: 32, p: 521, id: MaxID + 1, err: ErrInvalidID},
+	}
+	for _, tc := range testCases {
+		if _, err := SearchID(tc.w, tc.p, tc.id, 1); !errors.Is(err, tc.err) {
+			t.Errorf("SearchID(%v, %v, %v) error = \"%v\", want \"%v\".", tc.w, tc.p, tc.id, err, tc.err)
+		}
+	}
+	if _, err := Search(33, 521, 1); !errors.Is(err, ErrInvalidWordSize) {
+		t.Errorf("Search() error = \"%v\", want \"%v\".", err, ErrInvalidWordSize)
+	}
+	if _, err := SearchIDs(32, 521, 2, 1, 1); !errors.Is(err, ErrInvalidID) {
+		t.Errorf("SearchIDs() error = \"%v\", want \"%v\".", err, ErrInvalidID)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	// regression values (the characteristic polynomials are checked to be primitive below)
+	testCases := []struct {
+		w, p, id int
+		params   Params
+	}{
+		{w: 32, p: 521, id: -1, params: Params{W: 32, P: 521, N: 17, M: 8, R: 23, A: 0xf0c1e1b8, Shift0: 12, Shift1: 18, ShiftB: 7, ShiftC: 15, MaskB: 0x74bf5f80, MaskC: 0xffd78000}},
+		{w: 32, p: 521, id: 999, params: Params{W: 32, P: 521, N: 17, M: 8, R: 23, A: 0x876e03e7, Shift0: 12, Shift1: 18, ShiftB: 7, ShiftC: 15, MaskB: 0xb5bedd80, MaskC: 0xffdf8000}},
+		{w: 31, p: 521, id: 999, params: Params{W: 31, P: 521, N: 17, M: 8, R: 6, A: 0x76a203e7, Shift0: 12, Shift1: 18, ShiftB: 7, ShiftC: 15, MaskB: 0x36b4df80, MaskC: 0x7dee0000}},
+		{w: 32, p: 607, id: 1, params: Params{W: 32, P: 607, N: 19, M: 9, R: 1, A: 0xf03e0001, Shift0: 12, Shift1: 18, ShiftB: 7, ShiftC: 15, MaskB: 0x32f77780, MaskC: 0xef658000}},
+	}
+	for _, tc := range testCases {
+		var params *Params
+		var err error
+		if tc.id < 0 {
+			params, err = Search(tc.w, tc.p, 4172)
+		} else {
+			params, err = SearchID(tc.w, tc.p, tc.id, 4172)
+		}
+		if err != nil {
+			t.Errorf("SearchID(%v, %v, %v) error = \"%v\", want <nil>.", tc.w, tc.p, tc.id, err)
+			continue
+		}
+		if *params != tc.params {
+			t.Errorf("SearchID(%v, %v, %v) = %+v, want %+v.", tc.w, tc.p, tc.id, *params, tc.params)
+		}
+		if tc.id >= 0 && int(params.A&MaxID) != tc.id {
+			t.Errorf("ID of A = %v, want %v.", params.A&MaxID, tc.id)
+		}
+		if params.A>>(params.W-1) != 1 {
+			t.Errorf("MSB of A = %v, want 1.", params.A>>(params.W-1))
+		}
+		if params.N*params.W-params.R != params.P {
+			t.Errorf("N*W-R = %v, want %v.", params.N*params.W-params.R, params.P)
+		}
+		if !isPrimitive(params) {
+			t.Errorf("characteristic polynomial of %+v is not primitive.", *params)
+		}
+	}
+}
+
+func TestSearchIDs(t *testing.T) {
+	list, err := SearchIDs(32, 521, 0, 2, 4172)
+	if err != nil {
+		t.Fatalf("SearchIDs() error = \"%v\", want <nil>.", err)
+	}
+	res := []uint32{0xe84e0000, 0x916b0001, 0xf6470002} // regression values (checked to be primitive below)
+	if len(list) != len(res) {
+		t.Fatalf("len(SearchIDs()) = %v, want %v.", len(list), len(res))
+	}
+	for i, params := range list {
+		if params.A != res[i] {
+			t.Errorf("SearchIDs()[%v].A = %#x, want %#x.", i, params.A, res[i])
+		}
+		if !isPrimitive(params) {
+			t.Errorf("characteristic polynomial of %+v is not primitive.", *params)
+		}
+	}
+}
+
+func TestIrredPolys(t *testing.T) {
+	if len(irredPolys) != 127 { // NIRREDPOLY of the original DC
+		t.Errorf("len(irredPolys) = %v, want %v.", len(irredPolys), 127)
+	}
+}
+
+func TestCheckPeriod(t *testing.T) {
+	count := 300
+	if testing.Short() {
+		count = 100
+	}
+	for _, w := range []int{32, 31} {
+		sr, err := newSearcher(w, 521, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		irred := 0
+		for i := 0; i < count; i++ {
+			a := sr.nextA(0, 0)
+			phi := charPoly(sr.params, a)
+			if r, want := sr.pre.isReducible(a), hasSmallFactor(phi); r != want {
+				t.Errorf("prescreener.isReducible(%#x) = %v, want %v.", a, r, want)
+			}
+			if sr.pre.isReducible(a) {
+				continue
+			}
+			r, want := sr.ck.isIrreducible(mt19937ar.New(int64(i)), a), isIrreducible(phi, sr.params.P)
+			if r != want {
+				t.Errorf("checker.isIrreducible(%#x) = %v, want %v.", a, r, want)
+			}
+			if r {
+				irred++
+			}
+		}
+		if irred == 0 {
+			t.Errorf("no irreducible characteristic polynomial in %v candidates (w = %v).", count, w)
+		}
+	}
+}
+
+// charPoly returns the characteristic polynomial of MT with A == a (see newPrescreener function).
+func charPoly(params Params, a uint32) gf2.Poly {
+	n, m, r, w := params.N, params.M, params.R, params.W
+	term := func(e1, e2 int) gf2.Poly { // (t^n+t^m)^e1 (t^(n-1)+t^(m-1))^e2
+		f := gf2.New(params.P)
+		f[0] = 1
+		for i := 0; i < e1+e2; i++ {
+			g := gf2.New(params.P)
+			if i < e1 {
+				g.AddShift(f, n)
+				g.AddShift(f, m)
+			} else {
+				g.AddShift(f, n-1)
+				g.AddShift(f, m-1)
+			}
+			f = g
+		}
+		return f
+	}
+	phi := term(w-r, r)
+	for i := 0; i < w; i++ {
+		if a>>i&1 == 0 {
+			continue
+		}
+		if i < r {
+			phi.AddShift(term(w-r, r-1-i), 0)
+		} else {
+			phi.AddShift(term(w-1-i, 0), 0)
+		}
+	}
+	return phi
+}
+
+// isIrreducible returns true if t^(2^p) == t mod phi (phi is irreducible if p is a Mersenne exponent).
+func isIrreducible(phi gf2.Poly, p int) bool {
+	if phi.Degree() != p {
+		return false
+	}
+	return gf2.NewModulus(phi).ExpX2(p).Equal(gf2.Poly{2})
+}
+
+// hasSmallFactor returns true if phi has an irreducible factor of degree maxIrredDeg or less.
+func hasSmallFactor(phi gf2.Poly) bool {
+	for _, f := range irredPolys {
+		if gf2.Mod(phi, gf2.Poly{uint64(f)}).IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
+func isPrimitive(params *Params) bool {
+	return isIrreducible(charPoly(*params, params.A), params.P)
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,287 @@
+package dc
+
+import (
+	"math/bits"
+)
+
+const (
+	shift0        = 12 //tempering shifts (S00, S01, SSS and TTT)
+	shift1        = 18
+	shiftB        = 7
+	shiftC        = 15
+	limitVBestOpt = 15 //number of most significant bits optimized with all candidates (LIMIT_V_BEST_OPT)
+)
+
+// setTempering searches tempering masks which make k-distributions to v-bit accuracy as large as possible
+// (_get_tempering_parameter_hard_dc function).
+func (params *Params) setTempering() {
+	eq := newEqdeg(params)
+	nodes := []maskNode{{}}
+	for v := 0; v < limitVBestOpt; v++ {
+		nodes = eq.optimizeHard(v, nodes)
+	}
+	eq.optimize(nodes[0].b, nodes[0].c, limitVBestOpt)
+	params.Shift0 = shift0
+	params.Shift1 = shift1
+	params.ShiftB = shiftB
+	params.ShiftC = shiftC
+	params.MaskB = eq.maskB >> eq.gap
+	params.MaskC = eq.maskC >> eq.gap
+}
+
+// eqdeg is a context of search of tempering masks (eqdeg.c).
+// Words are shifted to the left by gap bits (so the most significant bit is always bit 31).
+type eqdeg struct {
+	m, n, r, w   int
+	gap          int
+	aaa          [2]uint32
+	upperMask    uint32
+	lowerMask    uint32
+	realMask     uint32
+	maskB, maskC uint32
+	upperVBits   uint32
+}
+
+// maskNode is a candidate of tempering masks.
+type maskNode struct {
+	b, c uint32
+}
+
+func newEqdeg(params *Params) *eqdeg {
+	gap := 32 - params.W
+	lowerMask := uint32(1)<<params.R - 1
+	eq := &eqdeg{
+		m: params.M, n: params.N, r: params.R, w: params.W,
+		gap:       gap,
+		aaa:       [2]uint32{0, params.A << gap},
+		upperMask: ^lowerMask << gap,
+		lowerMask: lowerMask << gap,
+	}
+	eq.realMask = eq.upperMask | eq.lowerMask
+	return eq
+}
+
+// bitmask returns the i-th bit from the most significant bit.
+func bitmask(i int) uint32 {
+	return 0x80000000 >> i
+}
+
+// optimizeHard returns all candidates of masks whose k-distributions to (v+1)-bit accuracy are maximum
+// (optimize_v_hard function).
+func (eq *eqdeg) optimizeHard(v int, prev []maskNode) []maskNode {
+	maxLen := -1
+	cur := []maskNode{}
+	for _, node := range prev {
+		for _, cand := range eq.pushStack(node.b, node.c, v) {
+			eq.maskB, eq.maskC = cand.b, cand.c
+			t := eq.pivotReduction(v + 1)
+			if t > maxLen {
+				maxLen = t
+				cur = cur[:0]
+			}
+			if t == maxLen {
+				cur = append(cur, cand)
+			}
+		}
+	}
+	return cur
+}
+
+// optimize determines masks greedily from v-th bit to the least significant bit (optimize_v function).
+func (eq *eqdeg) optimize(b, c uint32, v int) {
+	for {
+		cands := eq.pushStack(b, c, v)
+		maxLen, maxI := 0, 0
+		if len(cands) > 1 {
+			for i, cand := range cands {
+				eq.maskB, eq.maskC = cand.b, cand.c
+				if t := eq.pivotReduction(v + 1); t > maxLen {
+					maxLen, maxI = t, i
+				}
+			}
+		}
+		b, c = cands[maxI].b, cands[maxI].c
+		if v >= eq.w-1 {
+			eq.maskB, eq.maskC = b, c
+			return
+		}
+		v++
+	}
+}
+
+// pushStack returns candidates of masks whose v-th bits are determined (push_stack function).
+func (eq *eqdeg) pushStack(b, c uint32, v int) []maskNode {
+	cands := make([]maskNode, 0, 8)
+	if v+shiftC < eq.w {
+		cands = eq.pushMask(cands, v, b, c|bitmask(v))
+		cands = eq.pushMask(cands, v, b, c&^bitmask(v))
+	} else {
+		cands = eq.pushMask(cands, v, b, c)
+	}
+	return cands
+}
+
+// pushMask appends candidates of mask b for mask c (push_mask function).
+func (eq *eqdeg) pushMask(cands []maskNode, v int, b, c uint32) []maskNode {
+	var bv, bvt []uint32
+	switch {
+	case shiftB+v >= eq.w:
+		bv = []uint32{0}
+	case v >= shiftC && c&bitmask(v-shiftC) != 0:
+		bv = []uint32{b & bitmask(v)}
+	default:
+		bv = []uint32{bitmask(v), 0}
+	}
+	if v+shiftC+shiftB < eq.w && c&bitmask(v) != 0 {
+		bvt = []uint32{bitmask(v + shiftC), 0}
+	} else {
+		bvt = []uint32{0}
+	}
+	bmask := bitmask(v)
+	if v+shiftC < eq.w {
+		bmask |= bitmask(v + shiftC)
+	}
+	bmask = ^bmask
+	for _, t := range bvt {
+		for _, u := range bv {
+			cands = append(cands, maskNode{b: b&bmask | u | t, c: c})
+		}
+	}
+	return cands
+}
+
+// vector is an element of the lattice: a state of MT and the following output (Vector).
+type vector struct {
+	cf    []uint32 //state
+	start int      //beginning of state
+	count int      //number of steps (degree)
+	next  uint32   //most significant v bits of the next output
+}
+
+// pivotReduction returns k-distribution to v-bit accuracy by lattice reduction (pivot_reduction function).
+func (eq *eqdeg) pivotReduction(v int) int {
+	eq.upperVBits = ^uint32(0) << (32 - v)
+	lattice := eq.makeLattice(v)
+	limit := eq.n*(eq.w-1) - eq.r
+	for {
+		pivot := bits.LeadingZeros32(lattice[v].next)
+		if lattice[pivot].count < lattice[v].count {
+			lattice[pivot], lattice[v] = lattice[v], lattice[pivot]
+		}
+		eq.add(lattice[v], lattice[pivot])
+		if lattice[v].next == 0 {
+			count := 0
+			eq.nextState(lattice[v], &count)
+			if lattice[v].next == 0 {
+				if isZero(lattice[v]) {
+					break
+				}
+				for lattice[v].next == 0 {
+					count++
+					eq.nextState(lattice[v], &count)
+					if count > limit {
+						break
+					}
+				}
+				if count > limit {
+					break
+				}
+			}
+		}
+	}
+	k := lattice[0].count
+	for i := 1; i < v; i++ {
+		k = min(k, lattice[i].count)
+	}
+	return k
+}
+
+// makeLattice returns initial lattice of v+1 vectors (make_lattice function).
+func (eq *eqdeg) makeLattice(v int) []*vector {
+	lattice := make([]*vector, v+1)
+	for i := 0; i < v; i++ {
+		lattice[i] = &vector{cf: make([]uint32, eq.n), next: bitmask(i)}
+	}
+	bottom := &vector{cf: make([]uint32, eq.n)}
+	bottom.cf[eq.n-1] = 0xc0000000 & eq.realMask
+	count := 0
+	for {
+		eq.nextState(bottom, &count)
+		if bottom.next != 0 {
+			break
+		}
+	}
+	lattice[v] = bottom
+	return lattice
+}
+
+// add adds vector u to vector v (add function).
+func (eq *eqdeg) add(v, u *vector) {
+	sv, su := v.start, u.start
+	for i := 0; i < eq.n; i++ {
+		v.cf[sv] ^= u.cf[su]
+		if sv++; sv >= eq.n {
+			sv = 0
+		}
+		if su++; su >= eq.n {
+			su = 0
+		}
+	}
+	v.next ^= u.next
+}
+
+// isZero returns true if the state of v is zero.
+func isZero(v *vector) bool {
+	for _, x := range v.cf {
+		if x != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// nextState steps v until the next output is not zero (next_state function).
+func (eq *eqdeg) nextState(v *vector, count *int) {
+	n := eq.n
+	limit := n*(eq.w-1) - eq.r
+	for {
+		x := (v.cf[v.start] & eq.upperMask) | (v.cf[(v.start+1)%n] & eq.lowerMask)
+		x = v.cf[(v.start+eq.m)%n] ^ (x >> 1) ^ eq.aaa[(x>>eq.gap)&1]
+		x &= eq.realMask
+		v.cf[v.start] = x
+		v.start = (v.start + 1) % n
+		v.count++
+		x ^= (x >> shift0) & eq.realMask
+		x ^= (x << shiftB) & eq.maskB
+		x ^= (x << shiftC) & eq.maskC
+		v.next = x & eq.upperVBits
+		*count++
+		if *count > limit || v.next != 0 {
+			return
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel, fork from Dynamic Creator of Mersenne Twister "dcmt".
+ * (http://www.math.sci.hiroshima-u.ac.jp/m-mat/MT/DC/dc.html)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,113 @@
+package dc
+
+import (
+	"testing"
+
+	"github.com/goark/mt/v2/gf2"
+)
+
+func TestEquidistribution(t *testing.T) {
+	for _, w := range []int{32, 31} {
+		params, err := SearchID(w, 521, 999, 4172)
+		if err != nil {
+			t.Fatal(err)
+		}
+		kv := equidistribution(params)
+		eq := newEqdeg(params)
+		eq.maskB, eq.maskC = params.MaskB<<eq.gap, params.MaskC<<eq.gap
+		for v := 1; v <= w; v++ {
+			if k := eq.pivotReduction(v); k != kv[v] {
+				t.Errorf("eqdeg.pivotReduction(%v) = %v, want %v (W = %v).", v, k, kv[v], w)
+			}
+			if kv[v] > params.P/v {
+				t.Errorf("k(%v) = %v, want <= %v (W = %v).", v, kv[v], params.P/v, w)
+			}
+		}
+		if kv[1] != params.P {
+			t.Errorf("k(1) = %v, want %v (W = %v).", kv[1], params.P, w)
+		}
+	}
+}
+
+// equidistribution returns k(v) (k-distribution to v-bit accuracy) of Source for v = 1 to W
+// by Gaussian elimination of output bits as linear functions of the initial state.
+func equidistribution(params *Params) []int {
+	n, w, r, p := params.N, params.W, params.R, params.P
+	steps := p + 1
+	size := (p + 63) / 64
+	// functions[k][j] is j-th bit (from MSB) of k-th output
+	functions := make([][]gf2.Poly, steps)
+	for k := range functions {
+		functions[k] = make([]gf2.Poly, w)
+		for j := range functions[k] {
+			functions[k][j] = make(gf2.Poly, size)
+		}
+	}
+	b := 0
+	for i := 0; i < n; i++ {
+		lo := 0
+		if i == 0 {
+			lo = r // only upper w-r bits of state[0]
+		}
+		for bit := lo; bit < w; bit++ {
+			s := &Source{p: *params, state: make([]uint32, n), i: n}
+			s.state[i] = 1 << bit
+			for k := 0; k < steps; k++ {
+				x := s.Uint32()
+				for j := 0; j < w; j++ {
+					functions[k][j][b/64] |= uint64(x>>(w-1-j)&1) << (b % 64)
+				}
+			}
+			b++
+		}
+	}
+	kv := make([]int, w+1)
+	for v := 1; v <= w; v++ {
+		pivots := map[int]gf2.Poly{}
+	loop:
+		for k := 0; k < steps; k++ {
+			for j := 0; j < v; j++ {
+				row := functions[k][j].Clone()
+				for {
+					lead := row.Degree()
+					if lead < 0 {
+						break loop // linearly dependent
+					}
+					pv, ok := pivots[lead]
+					if !ok {
+						pivots[lead] = row
+						break
+					}
+					for i := range row {
+						row[i] ^= pv[i]
+					}
+				}
+			}
+			kv[v] = k + 1
+		}
+	}
+	return kv
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,60 @@
+package dc_test
+
+import (
+	"fmt"
+
+	"github.com/goark/mt/v2/dc"
+)
+
+func ExampleSearchID() {
+	// parameter set for generator ID 999 (word size 32, period 2^521-1)
+	params, err := dc.SearchID(32, 521, 999, 4172)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%#x\n", params.A)
+	fmt.Println(params.New(3241).Uint32())
+	//Output:
+	//0x876e03e7
+	//1340811730
+}
+
+func ExampleSearchIDs() {
+	// mathematically distinct generators for IDs 0 to 2
+	list, err := dc.SearchIDs(32, 521, 0, 2, 4172)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, params := range list {
+		fmt.Printf("%#x\n", params.A) // lower 16 bits are the ID
+	}
+	//Output:
+	//0xe84e0000
+	//0x916b0001
+	//0xf6470002
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,149 @@
+package dc
+
+const maxIrredDeg = 9 //maximum degree of irreducible polynomials for prescreening (MAX_IRRED_DEG)
+
+// prescreener rejects candidates of A whose characteristic polynomials have small factors (prescr.c).
+// Polynomials over GF(2) of small degree are represented as bits of uint32 (bit i is the coefficient of t^i).
+type prescreener struct {
+	base  []uint32   //characteristic polynomial with A == 0 modulo each irreducible polynomial
+	coefs [][]uint32 //contribution of each bit of A modulo each irreducible polynomial
+}
+
+// irredPolys is a list of all irreducible polynomials whose degrees are from 1 to maxIrredDeg.
+var irredPolys = makeIrredPolys()
+
+func makeIrredPolys() []uint32 {
+	list := []uint32{}
+	for f := uint32(2); f < 1<<(maxIrredDeg+1); f++ {
+		irred := true
+		for _, g := range list {
+			if 2*degree(g) > degree(f) {
+				break
+			}
+			if modPoly(f, g) == 0 {
+				irred = false
+				break
+			}
+		}
+		if irred {
+			list = append(list, f)
+		}
+	}
+	return list
+}
+
+// newPrescreener returns prescreener (_InitPrescreening_dc function).
+//
+// The characteristic polynomial of MT with A = (a_{w-1}, ..., a_0) is
+//
+//	(t^n+t^m)^(w-r) (t^(n-1)+t^(m-1))^r
+//	  + sum_{i=0}^{r-1} a_i (t^n+t^m)^(w-r) (t^(n-1)+t^(m-1))^(r-1-i)
+//	  + sum_{i=r}^{w-1} a_i (t^n+t^m)^(w-1-i)
+//
+// and it is computed modulo each irreducible polynomial.
+func newPrescreener(m, n, r, w int) *prescreener {
+	pre := &prescreener{
+		base:  make([]uint32, len(irredPolys)),
+		coefs: make([][]uint32, len(irredPolys)),
+	}
+	for k, f := range irredPolys {
+		tn := powT(n, f) ^ powT(m, f)
+		tn1 := powT(n-1, f) ^ powT(m-1, f)
+		coefs := make([]uint32, w)
+		for i := r; i < w; i++ {
+			coefs[i] = powPoly(tn, w-1-i, f)
+		}
+		u := powPoly(tn, w-r, f)
+		for i := 0; i < r; i++ {
+			coefs[i] = mulPoly(u, powPoly(tn1, r-1-i, f), f)
+		}
+		pre.base[k] = mulPoly(u, powPoly(tn1, r, f), f)
+		pre.coefs[k] = coefs
+	}
+	return pre
+}
+
+// isReducible returns true if the characteristic polynomial with a has a factor of degree maxIrredDeg or less
+// (_prescreening_dc function).
+func (pre *prescreener) isReducible(a uint32) bool {
+	for k, coefs := range pre.coefs {
+		x := pre.base[k]
+		for i, c := range coefs {
+			if a>>i&1 != 0 {
+				x ^= c
+			}
+		}
+		if x == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// degree returns the degree of polynomial f.
+func degree(f uint32) int {
+	d := -1
+	for ; f != 0; f >>= 1 {
+		d++
+	}
+	return d
+}
+
+// modPoly returns g mod f.
+func modPoly(g, f uint32) uint32 {
+	df := degree(f)
+	for dg := degree(g); dg >= df; dg = degree(g) {
+		g ^= f << (dg - df)
+	}
+	return g
+}
+
+// mulPoly returns g*h mod f (g and h are reduced modulo f).
+func mulPoly(g, h, f uint32) uint32 {
+	x := uint32(0)
+	for ; h != 0; h >>= 1 {
+		if h&1 != 0 {
+			x ^= g
+		}
+		g = modPoly(g<<1, f)
+	}
+	return x
+}
+
+// powPoly returns g^e mod f.
+func powPoly(g uint32, e int, f uint32) uint32 {
+	x := modPoly(1, f)
+	for ; e > 0; e-- {
+		x = mulPoly(x, g, f)
+	}
+	return x
+}
+
+// powT returns t^e mod f.
+func powT(e int, f uint32) uint32 {
+	return powPoly(modPoly(2, f), e, f)
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel, fork from Dynamic Creator of Mersenne Twister "dcmt".
+ * (http://www.math.sci.hiroshima-u.ac.jp/m-mat/MT/DC/dc.html)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
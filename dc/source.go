@@ -0,0 +1,228 @@
+package dc
+
+import (
+	"github.com/goark/mt/v2"
+)
+
+// Source is a source of random numbers (Mersenne Twister with a parameter set generated by Dynamic Creator).
+// Zero value of Source has no parameter set; it cannot be seeded and must not be used.
+type Source struct {
+	p     Params
+	state []uint32
+	i     int
+}
+
+var _ mt.Source = (*Source)(nil) //Source is compatible with mt.Source interface
+var _ mt.Seeder = (*Source)(nil) //Source is compatible with mt.Seeder interface
+
+// New returns a new pseudo-random source with the parameter set seeded with the given value.
+// Only the lower 32 bits of seed are used (same as sgenrand_mt function).
+// It panics if p is not a valid parameter set.
+func (p Params) New(seed int64) *Source {
+	rng := &Source{p: p}
+	rng.Seed(seed)
+	return rng
+}
+
+// NewWithArray returns a new pseudo-random source with the parameter set seeded with the given values.
+// Only the lower 32 bits of each seed are used.
+// It panics if p is not a valid parameter set.
+func (p Params) NewWithArray(seeds []uint64) *Source {
+	rng := &Source{p: p}
+	rng.SeedArray(seeds)
+	return rng
+}
+
+// valid returns true if the parameter set is available for generation.
+func (p Params) valid() bool {
+	if (p.W != 31 && p.W != 32) || p.N < 2 || p.M < 1 || p.M >= p.N || p.R < 1 || p.R >= p.W {
+		return false
+	}
+	for _, sh := range []int{p.Shift0, p.Shift1, p.ShiftB, p.ShiftC} {
+		if sh < 0 || sh >= p.W {
+			return false
+		}
+	}
+	return true
+}
+
+// Params returns the parameter set of Source.
+func (s *Source) Params() Params {
+	if s == nil {
+		return Params{}
+	}
+	return s.p
+}
+
+// Seed initializes Source with a seed (sgenrand_mt function).
+// It panics if Source has no valid parameter set.
+func (s *Source) Seed(seed int64) {
+	if s == nil {
+		return
+	}
+	if !s.p.valid() {
+		panic("dc: invalid parameter set")
+	}
+	s.init(uint32(seed))
+	s.mask()
+}
+
+// SeedArray initializes Source with seeds array (in the same way as init_by_array function of mt19937ar).
+// The recurrence runs on 32-bit words, and the words are masked to W bits at the end.
+// It panics if Source has no valid parameter set.
+func (s *Source) SeedArray(seeds []uint64) {
+	if s == nil {
+		return
+	}
+	if !s.p.valid() {
+		panic("dc: invalid parameter set")
+	}
+	s.init(19650218)
+	if len(seeds) == 0 {
+		s.mask()
+		return
+	}
+	nn := s.p.N
+	st := s.state
+	k := max(nn, len(seeds))
+	i := 1
+	j := 0
+	for ; k > 0; k-- {
+		st[i] = (st[i] ^ ((st[i-1] ^ (st[i-1] >> 30)) * 1664525)) + uint32(seeds[j]) + uint32(j) // non linear
+		i++
+		if i >= nn {
+			st[0] = st[nn-1]
+			i = 1
+		}
+		j++
+		if j >= len(seeds) {
+			j = 0
+		}
+	}
+	for k = nn - 1; k > 0; k-- {
+		st[i] = (st[i] ^ ((st[i-1] ^ (st[i-1] >> 30)) * 1566083941)) - uint32(i) // non linear
+		i++
+		if i >= nn {
+			st[0] = st[nn-1]
+			i = 1
+		}
+	}
+	s.mask()
+	st[0] = 1 << (s.p.W - 1) //MSB is 1; assuring non-zero initial array
+}
+
+// init fills the state with the recurrence of sgenrand_mt function on 32-bit words (not masked yet).
+func (s *Source) init(seed uint32) {
+	s.state = make([]uint32, s.p.N)
+	s.state[0] = seed
+	for i := 1; i < s.p.N; i++ {
+		s.state[i] = 1812433253*(s.state[i-1]^(s.state[i-1]>>30)) + uint32(i)
+	}
+	s.i = s.p.N
+}
+
+// mask masks the words of the state to W bits (a separate pass of sgenrand_mt function).
+func (s *Source) mask() {
+	wmask := uint32(0xffffffff) >> (32 - s.p.W)
+	for i := range s.state {
+		s.state[i] &= wmask
+	}
+}
+
+// Uint32 generates a random number on [0, 2^W-1]-interval (genrand_mt function).
+// It panics if Source is not seeded (zero value).
+func (s *Source) Uint32() uint32 {
+	if s == nil {
+		return 0
+	}
+	if len(s.state) == 0 {
+		panic("dc: invalid parameter set")
+	}
+	if s.i >= s.p.N {
+		s.generate()
+	}
+	x := s.state[s.i]
+	s.i++
+	x ^= x >> s.p.Shift0
+	x ^= (x << s.p.ShiftB) & s.p.MaskB
+	x ^= (x << s.p.ShiftC) & s.p.MaskC
+	x ^= x >> s.p.Shift1
+	return x
+}
+
+// generate generates next N words of the state.
+func (s *Source) generate() {
+	n, m := s.p.N, s.p.M
+	st := s.state
+	lmask := uint32(1)<<s.p.R - 1
+	umask := ^lmask
+	aa := [2]uint32{0, s.p.A}
+	k := 0
+	for ; k < n-m; k++ {
+		x := (st[k] & umask) | (st[k+1] & lmask)
+		st[k] = st[k+m] ^ (x >> 1) ^ aa[x&1]
+	}
+	for ; k < n-1; k++ {
+		x := (st[k] & umask) | (st[k+1] & lmask)
+		st[k] = st[k+m-n] ^ (x >> 1) ^ aa[x&1]
+	}
+	x := (st[n-1] & umask) | (st[0] & lmask)
+	st[n-1] = st[m-1] ^ (x >> 1) ^ aa[x&1]
+	s.i = 0
+}
+
+// Uint64 generates a random number on [0, 2^64-1]-interval.
+// It is composed of Uint32 values; the first one is the upper bits
+// (two values if W is 32, three values if W is 31).
+func (s *Source) Uint64() uint64 {
+	if s == nil {
+		return 0
+	}
+	x := uint64(0)
+	for b := 0; b < 64; b += s.p.W {
+		x = x<<s.p.W | uint64(s.Uint32())
+	}
+	return x
+}
+
+// Real generates a random number
+// on [0,1]-real-interval if mode==1,
+// on [0,1)-real-interval if mode==2,
+// on (0,1)-real-interval others
+//
+// Deprecated: use Source.Float64 method instead.
+func (s *Source) Real(mode int) float64 {
+	return s.Float64(mt.ModeInterval(mode))
+}
+
+// Float64 generates a random number on the interval iv (see Uint64 method).
+func (s *Source) Float64(iv mt.Interval) float64 {
+	if s == nil {
+		return 0.0
+	}
+	return iv.Float64(s.Uint64())
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel, fork from Dynamic Creator of Mersenne Twister "dcmt".
+ * (http://www.math.sci.hiroshima-u.ac.jp/m-mat/MT/DC/dc.html)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
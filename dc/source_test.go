@@ -0,0 +1,165 @@
+package dc
+
+import (
+	"testing"
+
+	"github.com/goark/mt/v2"
+)
+
+// parameter set by Search(32, 521, 4172)
+var params521 = Params{W: 32, P: 521, N: 17, M: 8, R: 23, A: 0xf0c1e1b8, Shift0: 12, Shift1: 18, ShiftB: 7, ShiftC: 15, MaskB: 0x74bf5f80, MaskC: 0xffd78000}
+
+// parameter set by SearchID(31, 521, 999, 4172)
+var params31 = Params{W: 31, P: 521, N: 17, M: 8, R: 6, A: 0x76a203e7, Shift0: 12, Shift1: 18, ShiftB: 7, ShiftC: 15, MaskB: 0x36b4df80, MaskC: 0x7dee0000}
+
+func TestSource(t *testing.T) {
+	// regression values (the first 20 outputs after Seed(3241))
+	testCases := []struct {
+		params Params
+		res    []uint32
+	}{
+		{params: params521, res: []uint32{
+			3488221650, 3259858212, 1010374051, 834698837, 3986802806,
+			3121629222, 162998133, 2167868955, 1642921001, 3009922635,
+			3454439124, 1596335057, 2874514488, 3580896254, 1148360779,
+			3904860375, 801516723, 2911648170, 3648298457, 741620052,
+		}},
+		{params: params31, res: []uint32{
+			1694259268, 1529113765, 1121042243, 562101924, 1960366214,
+			1820414352, 1510423705, 242548695, 1539002094, 2082295582,
+			2026258663, 1935626621, 894610057, 229166300, 1927060572,
+			1560541014, 944957067, 1890802752, 1663288248, 172635068,
+		}},
+	}
+	for _, tc := range testCases {
+		s := tc.params.New(3241)
+		for i, res := range tc.res {
+			if r := s.Uint32(); r != res {
+				t.Errorf("Source.Uint32() (W=%v) [%v] = %v, want %v.", tc.params.W, i, r, res)
+			}
+		}
+		if p := s.Params(); p != tc.params {
+			t.Errorf("Source.Params() = %v, want %v.", p, tc.params)
+		}
+	}
+}
+
+func TestSeedArray(t *testing.T) {
+	// DC has no init_by_array: outputs of init_by_array of mt19937ar with params521 (same as Uint32 of this package)
+	res := []uint32{555470137, 4140915505, 1409567720, 4275724273, 2737839902}
+	s := params521.NewWithArray([]uint64{0x123, 0x234, 0x345, 0x456})
+	for i, res := range res {
+		if r := s.Uint32(); r != res {
+			t.Errorf("Source.Uint32() [%v] = %v, want %v.", i, r, res)
+		}
+	}
+}
+
+func TestUint64(t *testing.T) {
+	testCases := []struct {
+		params Params
+		f      func(s *Source) uint64
+	}{
+		{params: params521, f: func(s *Source) uint64 { return uint64(s.Uint32())<<32 | uint64(s.Uint32()) }},
+		{params: params31, f: func(s *Source) uint64 {
+			return uint64(s.Uint32())<<62 | uint64(s.Uint32())<<31 | uint64(s.Uint32())
+		}},
+	}
+	for _, tc := range testCases {
+		s1, s2 := tc.params.New(1), tc.params.New(1)
+		for i := 0; i < 100; i++ {
+			if r, res := s1.Uint64(), tc.f(s2); r != res {
+				t.Errorf("Source.Uint64() [%v] = %v, want %v (W = %v).", i, r, res, tc.params.W)
+			}
+		}
+	}
+	s := params31.New(1)
+	for i := 0; i < 100; i++ {
+		if r := s.Uint32(); r>>31 != 0 {
+			t.Errorf("Source.Uint32() [%v] = %#x, want 31-bit value.", i, r)
+		}
+	}
+}
+
+func TestFloat(t *testing.T) {
+	s1, s2 := params521.New(1), params521.New(1)
+	for i := 0; i < 100; i++ {
+		if f, res := s1.Float64(mt.ClosedOpen), mt.ClosedOpen.Float64(s2.Uint64()); f != res {
+			t.Errorf("Source.Float64() [%v] = %v, want %v.", i, f, res)
+		}
+		if f, res := s1.Real(1), mt.Closed.Float64(s2.Uint64()); f != res {
+			t.Errorf("Source.Real() [%v] = %v, want %v.", i, f, res)
+		}
+	}
+}
+
+func TestInvalidParams(t *testing.T) {
+	negShift := params521
+	negShift.ShiftB = -1
+	overShift := params31
+	overShift.Shift1 = 31
+	testCases := []struct {
+		name string
+		f    func()
+	}{
+		{name: "Params{}.New()", f: func() { Params{}.New(1) }},
+		{name: "Params{}.NewWithArray()", f: func() { Params{}.NewWithArray([]uint64{1}) }},
+		{name: "New() with negative shift", f: func() { negShift.New(1) }},
+		{name: "NewWithArray() with shift >= W", f: func() { overShift.NewWithArray(nil) }},
+		{name: "<empty>.Seed()", f: func() { (&Source{}).Seed(1) }},
+		{name: "<empty>.SeedArray()", f: func() { (&Source{}).SeedArray(nil) }},
+		{name: "<empty>.Uint32()", f: func() { (&Source{}).Uint32() }},
+		{name: "<empty>.Uint64()", f: func() { (&Source{}).Uint64() }},
+	}
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%v does not panic.", tc.name)
+				}
+			}()
+			tc.f()
+		}()
+	}
+}
+
+func TestNil(t *testing.T) {
+	s := (*Source)(nil)
+	s.Seed(1)
+	s.SeedArray(nil)
+	if r := s.Uint32(); r != 0 {
+		t.Errorf("<nil>.Uint32() = \"%v\", want \"%v\".", r, 0)
+	}
+	if r := s.Uint64(); r != 0 {
+		t.Errorf("<nil>.Uint64() = \"%v\", want \"%v\".", r, 0)
+	}
+	if r := s.Real(0); r != 0 {
+		t.Errorf("<nil>.Real() = \"%v\", want \"%v\".", r, 0)
+	}
+	if p := s.Params(); p != (Params{}) {
+		t.Errorf("<nil>.Params() = \"%v\", want \"%v\".", p, Params{})
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,285 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/goark/mt/v2"
+)
+
+// Binomial is the binomial distribution; the number of successes in n trials with probability p.
+type Binomial struct {
+	n int
+	p float64
+}
+
+var _ Discrete = (*Binomial)(nil) //Binomial is compatible with Discrete interface
+
+// NewBinomial returns new Binomial instance with n (>= 0) trials and probability p (on [0,1]-interval).
+func NewBinomial(n int, p float64) (*Binomial, error) {
+	if n < 0 || !isProbability(p) {
+		return nil, fmt.Errorf("%w: Binomial(n=%v, p=%v)", ErrInvalidParameter, n, p)
+	}
+	return &Binomial{n: n, p: p}, nil
+}
+
+// Rand generates a random variate.
+// If n * min(p, 1-p) < 30, it uses the inversion method by sequential search.
+// Otherwise it uses the BTPE algorithm
+// (V. Kachitvichyanukul and B. W. Schmeiser, "Binomial random variate generation",
+// Communications of the ACM 31 (1988) 216-222).
+func (d *Binomial) Rand(src mt.Source) int {
+	if d == nil || src == nil {
+		return 0
+	}
+	return binomial(src, d.n, d.p)
+}
+
+// Mean returns the mean of the distribution.
+func (d *Binomial) Mean() float64 {
+	if d == nil {
+		return math.NaN()
+	}
+	return float64(d.n) * d.p
+}
+
+// Variance returns the variance of the distribution.
+func (d *Binomial) Variance() float64 {
+	if d == nil {
+		return math.NaN()
+	}
+	return float64(d.n) * d.p * (1 - d.p)
+}
+
+func binomial(src mt.Source, n int, p float64) int {
+	switch {
+	case n == 0 || p == 0:
+		return 0
+	case p == 1:
+		return n
+	}
+	r := math.Min(p, 1-p)
+	var y int
+	if float64(n)*r < 30 {
+		y = binomialInversion(src, n, r)
+	} else {
+		y = binomialBTPE(src, n, r)
+	}
+	if p > 0.5 {
+		return n - y
+	}
+	return y
+}
+
+func binomialInversion(src mt.Source, n int, p float64) int {
+	q := 1 - p
+	qn := math.Exp(float64(n) * math.Log1p(-p))
+	np := float64(n) * p
+	bound := math.Min(float64(n), np+10*math.Sqrt(np*q+1))
+	x := 0
+	px := qn
+	u := uniformClosedOpen(src)
+	for u > px {
+		x++
+		if float64(x) > bound {
+			x = 0
+			px = qn
+			u = uniformClosedOpen(src)
+			continue
+		}
+		u -= px
+		px = (float64(n-x+1) * p * px) / (float64(x) * q)
+	}
+	return x
+}
+
+// binomialBTPE generates a random variate by BTPE algorithm (p <= 0.5).
+func binomialBTPE(src mt.Source, n int, r float64) int {
+	nf := float64(n)
+	q := 1 - r
+	fm := nf*r + r
+	m := math.Floor(fm)
+	p1 := math.Floor(2.195*math.Sqrt(nf*r*q)-4.6*q) + 0.5
+	xm := m + 0.5
+	xl := xm - p1
+	xr := xm + p1
+	c := 0.134 + 20.5/(15.3+m)
+	a := (fm - xl) / (fm - xl*r)
+	laml := a * (1 + a/2)
+	a = (xr - fm) / (xr * q)
+	lamr := a * (1 + a/2)
+	p2 := p1 * (1 + 2*c)
+	p3 := p2 + c/laml
+	p4 := p3 + c/lamr
+	nrq := nf * r * q
+
+	for {
+		// Step 1: triangular region
+		u := uniformClosedOpen(src) * p4
+		v := uniformClosedOpen(src)
+		if u <= p1 {
+			return int(math.Floor(xm - p1*v + u))
+		}
+		var y float64
+		switch {
+		case u <= p2: // Step 2: parallelograms
+			x := xl + (u-p1)/c
+			v = v*c + 1 - math.Abs(m-x+0.5)/p1
+			if v > 1 {
+				continue
+			}
+			y = math.Floor(x)
+		case u <= p3: // Step 3: left exponential tail
+			y = math.Floor(xl + math.Log(v)/laml)
+			if y < 0 || v == 0 {
+				continue
+			}
+			v = v * (u - p2) * laml
+		default: // Step 4: right exponential tail
+			y = math.Floor(xr - math.Log(v)/lamr)
+			if y > nf || v == 0 {
+				continue
+			}
+			v = v * (u - p3) * lamr
+		}
+
+		// Step 5: acceptance/rejection comparison
+		k := math.Abs(y - m)
+		if k <= 20 || k >= nrq/2-1 {
+			// Step 5.1: evaluation of f(y) by recursive formula
+			s := r / q
+			a := s * (nf + 1)
+			f := 1.0
+			if m < y {
+				for i := m + 1; i <= y; i++ {
+					f *= a/i - s
+				}
+			} else if m > y {
+				for i := y + 1; i <= m; i++ {
+					f /= a/i - s
+				}
+			}
+			if v > f {
+				continue
+			}
+			return int(y)
+		}
+
+		// Step 5.2: squeezing
+		rho := (k / nrq) * ((k*(k/3+0.625)+0.16666666666666666)/nrq + 0.5)
+		t := -k * k / (2 * nrq)
+		alpha := math.Log(v)
+		if alpha < t-rho {
+			return int(y)
+		}
+		if alpha > t+rho {
+			continue
+		}
+
+		// Step 5.3: final acceptance/rejection test (Stirling's formula)
+		x1 := y + 1
+		f1 := m + 1
+		z := nf + 1 - m
+		w := nf - y + 1
+		if alpha > xm*math.Log(f1/x1)+(nf-m+0.5)*math.Log(z/w)+(y-m)*math.Log(w*r/(x1*q))+
+			stirlingCorrection(f1)+stirlingCorrection(z)+stirlingCorrection(x1)+stirlingCorrection(w) {
+			continue
+		}
+		return int(y)
+	}
+}
+
+// stirlingCorrection returns the correction term of Stirling's formula used in BTPE algorithm.
+func stirlingCorrection(x float64) float64 {
+	x2 := x * x
+	return (13860 - (462-(132-(99-140/x2)/x2)/x2)/x2) / x / 166320
+}
+
+// Multinomial is the multinomial distribution; the numbers of outcomes of each category in n trials.
+type Multinomial struct {
+	n     int
+	probs []float64
+}
+
+// NewMultinomial returns new Multinomial instance with n (>= 0) trials and probabilities of each category.
+// The probabilities are normalized by their sum.
+func NewMultinomial(n int, probs []float64) (*Multinomial, error) {
+	if n < 0 || len(probs) == 0 {
+		return nil, fmt.Errorf("%w: Multinomial(n=%v, probs=%v)", ErrInvalidParameter, n, probs)
+	}
+	sum := 0.0
+	for _, p := range probs {
+		if !(p >= 0) || math.IsInf(p, 1) {
+			return nil, fmt.Errorf("%w: Multinomial(n=%v, probs=%v)", ErrInvalidParameter, n, probs)
+		}
+		sum += p
+	}
+	if !isPositive(sum) {
+		return nil, fmt.Errorf("%w: Multinomial(n=%v, probs=%v)", ErrInvalidParameter, n, probs)
+	}
+	ps := make([]float64, len(probs))
+	for i, p := range probs {
+		ps[i] = p / sum
+	}
+	return &Multinomial{n: n, probs: ps}, nil
+}
+
+// Rand generates a random vector by the conditional binomial method.
+func (d *Multinomial) Rand(src mt.Source) []int {
+	if d == nil || src == nil {
+		return nil
+	}
+	xs := make([]int, len(d.probs))
+	rest := d.n
+	restp := 1.0
+	for i, p := range d.probs[:len(d.probs)-1] {
+		if rest == 0 {
+			break
+		}
+		if p >= restp {
+			xs[i] = rest
+			rest = 0
+			break
+		}
+		xs[i] = binomial(src, rest, p/restp)
+		rest -= xs[i]
+		restp -= p
+	}
+	xs[len(xs)-1] += rest
+	return xs
+}
+
+// Mean returns the mean vector of the distribution.
+func (d *Multinomial) Mean() []float64 {
+	if d == nil {
+		return nil
+	}
+	ms := make([]float64, len(d.probs))
+	for i, p := range d.probs {
+		ms[i] = float64(d.n) * p
+	}
+	return ms
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,168 @@
+package dist
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func binomialPMF(n int, p float64) func(int) float64 {
+	return func(k int) float64 {
+		if k < 0 || k > n {
+			return 0
+		}
+		return math.Exp(logChoose(n, k) + float64(k)*math.Log(p) + float64(n-k)*math.Log1p(-p))
+	}
+}
+
+func TestBinomial(t *testing.T) {
+	testCases := []struct {
+		n int
+		p float64
+	}{
+		{n: 1, p: 0.5},
+		{n: 20, p: 0.3},
+		{n: 100, p: 0.29},
+		{n: 100, p: 0.3},
+		{n: 1000, p: 0.4},
+		{n: 1000, p: 0.9},
+		{n: 1000000, p: 0.001},
+		{n: 1000000, p: 0.5},
+	}
+	src := newSource()
+	for _, tc := range testCases {
+		d, err := NewBinomial(tc.n, tc.p)
+		if err != nil {
+			t.Errorf("NewBinomial() is \"%v\", want nil.", err)
+			continue
+		}
+		name := fmt.Sprintf("Binomial(%v, %v)", tc.n, tc.p)
+		xs := samplingDiscrete(d, src)
+		checkMomentsDiscrete(t, name, d, xs, 0.03)
+		checkChiSquare(t, name, xs, d.Mean(), binomialPMF(tc.n, tc.p))
+	}
+	for _, tc := range []struct {
+		n   int
+		p   float64
+		res int
+	}{
+		{n: 0, p: 0.5, res: 0},
+		{n: 10, p: 0, res: 0},
+		{n: 10, p: 1, res: 10},
+	} {
+		d, _ := NewBinomial(tc.n, tc.p)
+		if r := d.Rand(src); r != tc.res {
+			t.Errorf("Binomial(%v, %v).Rand() = %v, want %v.", tc.n, tc.p, r, tc.res)
+		}
+	}
+}
+
+func TestMultinomial(t *testing.T) {
+	probs := []float64{1, 2, 3, 0, 4}
+	d, err := NewMultinomial(100, probs)
+	if err != nil {
+		t.Fatalf("NewMultinomial() is \"%v\", want nil.", err)
+	}
+	src := newSource()
+	sums := make([]float64, len(probs))
+	for i := 0; i < sampleSize; i++ {
+		xs := d.Rand(src)
+		total := 0
+		for j, x := range xs {
+			total += x
+			sums[j] += float64(x)
+		}
+		if total != 100 {
+			t.Errorf("sum of Multinomial.Rand() = %v, want %v.", total, 100)
+			break
+		}
+	}
+	for j, m := range d.Mean() {
+		p := probs[j] / 10
+		sd := math.Sqrt(100 * p * (1 - p) / sampleSize)
+		if mean := sums[j] / sampleSize; math.Abs(mean-m) > 5*sd || (p == 0 && mean != 0) {
+			t.Errorf("sample mean of Multinomial.Rand()[%v] = %v, want %v.", j, mean, m)
+		}
+	}
+	d, _ = NewMultinomial(0, []float64{1})
+	if xs := d.Rand(src); len(xs) != 1 || xs[0] != 0 {
+		t.Errorf("Multinomial(0).Rand() = %v, want %v.", xs, []int{0})
+	}
+}
+
+func TestStirlingCorrection(t *testing.T) {
+	for _, x := range []float64{2, 3, 5, 10, 25.5, 100, 1e4} {
+		// the first three terms of the series 1/(12x) - 1/(360x^3) + 1/(1260x^5) - 1/(1680x^7) + ...
+		series := 1/(12*x) - 1/(360*x*x*x) + 1/(1260*math.Pow(x, 5))
+		if c := stirlingCorrection(x); math.Abs(c-series) > 1/(1680*math.Pow(x, 7))+1e-15*series {
+			t.Errorf("stirlingCorrection(%v) = %v, want %v.", x, c, series)
+		}
+		if x < 5 || x > 100 {
+			continue
+		}
+		// exact value: ln Gamma(x) - ((x-1/2)ln(x) - x + ln(2 pi)/2)
+		lg, _ := math.Lgamma(x)
+		exact := lg - ((x-0.5)*math.Log(x) - x + 0.5*math.Log(2*math.Pi))
+		if c := stirlingCorrection(x); math.Abs(c-exact) > 1e-9 {
+			t.Errorf("stirlingCorrection(%v) = %v, want %v (exact).", x, c, exact)
+		}
+	}
+}
+
+func TestBinomialErr(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func() error
+	}{
+		{name: "Binomial(-1, 0.5)", f: func() error { _, err := NewBinomial(-1, 0.5); return err }},
+		{name: "Binomial(10, 1.5)", f: func() error { _, err := NewBinomial(10, 1.5); return err }},
+		{name: "Binomial(10, NaN)", f: func() error { _, err := NewBinomial(10, math.NaN()); return err }},
+		{name: "Multinomial(-1, [1])", f: func() error { _, err := NewMultinomial(-1, []float64{1}); return err }},
+		{name: "Multinomial(1, [])", f: func() error { _, err := NewMultinomial(1, nil); return err }},
+		{name: "Multinomial(1, [0, 0])", f: func() error { _, err := NewMultinomial(1, []float64{0, 0}); return err }},
+		{name: "Multinomial(1, [1, -1])", f: func() error { _, err := NewMultinomial(1, []float64{1, -1}); return err }},
+		{name: "Multinomial(1, [1, NaN])", f: func() error { _, err := NewMultinomial(1, []float64{1, math.NaN()}); return err }},
+	}
+	for _, tc := range testCases {
+		if err := tc.f(); !errors.Is(err, ErrInvalidParameter) {
+			t.Errorf("New%s is \"%v\", want \"%v\".", tc.name, err, ErrInvalidParameter)
+		}
+	}
+}
+
+func TestBinomialNil(t *testing.T) {
+	d := (*Binomial)(nil)
+	if r := d.Rand(newSource()); r != 0 {
+		t.Errorf("<nil>.Rand() = %v, want %v.", r, 0)
+	}
+	if m, v := d.Mean(), d.Variance(); !math.IsNaN(m) || !math.IsNaN(v) {
+		t.Errorf("<nil>.Mean(), Variance() = %v, %v, want NaN, NaN.", m, v)
+	}
+	if xs := (*Multinomial)(nil).Rand(newSource()); xs != nil {
+		t.Errorf("<nil>.Rand() = %v, want nil.", xs)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,75 @@
+// Package dist provides random variates of continuous and discrete probability distributions
+// drawn from mt.Source (or mt.PRNG).
+package dist
+
+import (
+	"errors"
+	"math"
+
+	"github.com/goark/mt/v2"
+)
+
+// ErrInvalidParameter is returned when a parameter of distribution is out of its domain.
+var ErrInvalidParameter = errors.New("invalid parameter of distribution")
+
+// Continuous is an interface of continuous probability distributions.
+type Continuous interface {
+	Rand(mt.Source) float64
+	Mean() float64
+	Variance() float64
+}
+
+// Discrete is an interface of discrete probability distributions.
+type Discrete interface {
+	Rand(mt.Source) int
+	Mean() float64
+	Variance() float64
+}
+
+// uniformClosedOpen generates a random number on [0,1)-real-interval with 53-bit resolution.
+func uniformClosedOpen(src mt.Source) float64 {
+	return mt.ClosedOpen.Float64(src.Uint64())
+}
+
+// uniformOpen generates a random number on (0,1)-real-interval.
+func uniformOpen(src mt.Source) float64 {
+	return mt.Open.Float64(src.Uint64())
+}
+
+// isPositive reports whether x is a positive finite number.
+func isPositive(x float64) bool {
+	return x > 0 && !math.IsInf(x, 1)
+}
+
+// isProbability reports whether p is on [0,1]-interval.
+func isProbability(p float64) bool {
+	return p >= 0 && p <= 1
+}
+
+// isFinite reports whether x is a finite number.
+func isFinite(x float64) bool {
+	return !math.IsNaN(x) && !math.IsInf(x, 0)
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,195 @@
+package dist
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/goark/mt/v2"
+	"github.com/goark/mt/v2/mt19937"
+)
+
+const sampleSize = 100000
+
+func newSource() mt.Source {
+	return mt19937.NewWithArray([]uint64{0x12345, 0x23456, 0x34567, 0x45678})
+}
+
+func sampling(d Continuous, src mt.Source) []float64 {
+	xs := make([]float64, sampleSize)
+	for i := range xs {
+		xs[i] = d.Rand(src)
+	}
+	return xs
+}
+
+type moments interface {
+	Mean() float64
+	Variance() float64
+}
+
+// checkMoments tests sample mean and variance
+func checkMoments(t *testing.T, name string, d moments, xs []float64, tol float64) {
+	t.Helper()
+	n := float64(len(xs))
+	sum := 0.0
+	for _, x := range xs {
+		sum += x
+	}
+	mean := sum / n
+	d2 := 0.0
+	for _, x := range xs {
+		d2 += (x - mean) * (x - mean)
+	}
+	variance := d2 / (n - 1)
+	if math.Abs(mean-d.Mean()) > 5*math.Sqrt(d.Variance()/n) {
+		t.Errorf("%s: sample mean = %v, want %v.", name, mean, d.Mean())
+	}
+	if math.Abs(variance-d.Variance()) > tol*d.Variance() {
+		t.Errorf("%s: sample variance = %v, want %v.", name, variance, d.Variance())
+	}
+}
+
+// checkKS tests by Kolmogorov-Smirnov test (significance level 0.001)
+func checkKS(t *testing.T, name string, xs []float64, cdf func(float64) float64) {
+	t.Helper()
+	xs = slices.Clone(xs)
+	slices.Sort(xs)
+	n := float64(len(xs))
+	dmax := 0.0
+	for i, x := range xs {
+		f := cdf(x)
+		dmax = math.Max(dmax, math.Max(f-float64(i)/n, float64(i+1)/n-f))
+	}
+	if dmax*math.Sqrt(n) > 1.95 {
+		t.Errorf("%s: Kolmogorov-Smirnov statistic = %v, want <= %v.", name, dmax, 1.95/math.Sqrt(n))
+	}
+}
+
+func normalCDF(mu, sigma float64) func(float64) float64 {
+	return func(x float64) float64 {
+		return 0.5 * math.Erfc(-(x-mu)/(sigma*math.Sqrt2))
+	}
+}
+
+// gammaCDF returns regularized lower incomplete gamma function P(shape, x/scale)
+func gammaCDF(shape, scale float64) func(float64) float64 {
+	lg, _ := math.Lgamma(shape)
+	return func(x float64) float64 {
+		x /= scale
+		if x <= 0 {
+			return 0
+		}
+		term := 1 / shape
+		sum := term
+		for n := 1.0; n < 10000 && term > sum*1e-16; n++ {
+			term *= x / (shape + n)
+			sum += term
+		}
+		return math.Min(1, sum*math.Exp(shape*math.Log(x)-x-lg))
+	}
+}
+
+func samplingDiscrete(d Discrete, src mt.Source) []int {
+	xs := make([]int, sampleSize)
+	for i := range xs {
+		xs[i] = d.Rand(src)
+	}
+	return xs
+}
+
+// checkMomentsDiscrete tests sample mean and variance
+func checkMomentsDiscrete(t *testing.T, name string, d Discrete, xs []int, tol float64) {
+	t.Helper()
+	fs := make([]float64, len(xs))
+	for i, x := range xs {
+		fs[i] = float64(x)
+	}
+	checkMoments(t, name, d, fs, tol)
+}
+
+// checkChiSquare tests by chi-squared goodness of fit test (significance level 0.001).
+// Bins with small expected frequency (< 5) in both tails are merged.
+func checkChiSquare(t *testing.T, name string, xs []int, mean float64, pmf func(int) float64) {
+	t.Helper()
+	n := float64(len(xs))
+	lo := int(math.Floor(mean))
+	for n*pmf(lo-1) >= 5 {
+		lo--
+	}
+	hi := int(math.Floor(mean))
+	for n*pmf(hi+1) >= 5 {
+		hi++
+	}
+	expected := make([]float64, hi-lo+3) //with both tails
+	rest := 1.0
+	for k := lo; k <= hi; k++ {
+		expected[k-lo+1] = n * pmf(k)
+		rest -= pmf(k)
+	}
+	lower := 0.0
+	for k := lo - 1; k >= lo-10000; k-- {
+		p := pmf(k)
+		if p == 0 && k < 0 {
+			break
+		}
+		lower += p
+	}
+	expected[0] = n * lower
+	expected[len(expected)-1] = n * math.Max(0, rest-lower)
+	observed := make([]float64, len(expected))
+	for _, x := range xs {
+		switch {
+		case x < lo:
+			observed[0]++
+		case x > hi:
+			observed[len(observed)-1]++
+		default:
+			observed[x-lo+1]++
+		}
+	}
+	chi2 := 0.0
+	df := -1.0
+	for i, e := range expected {
+		if e < 1e-9 {
+			if observed[i] > 0 {
+				t.Errorf("%s: %v values are out of the support.", name, observed[i])
+			}
+			continue
+		}
+		chi2 += (observed[i] - e) * (observed[i] - e) / e
+		df++
+	}
+	if df < 1 {
+		return
+	}
+	// critical value by Wilson-Hilferty approximation
+	c := 2 / (9 * df)
+	crit := df * math.Pow(1-c+3.09*math.Sqrt(c), 3)
+	if chi2 > crit {
+		t.Errorf("%s: chi-squared statistic = %v (df = %v), want <= %v.", name, chi2, df, crit)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,51 @@
+package dist_test
+
+import (
+	"fmt"
+
+	"github.com/goark/mt/v2"
+	"github.com/goark/mt/v2/dist"
+	"github.com/goark/mt/v2/mt19937"
+)
+
+func ExampleNormal() {
+	d, err := dist.NewNormal(50, 10)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	prng := mt.New(mt19937.New(19650218))
+	fmt.Printf("%.4f\n", d.Rand(prng))
+	//Output:
+	//51.5551
+}
+
+func ExampleNewGamma() {
+	_, err := dist.NewGamma(-1, 1)
+	fmt.Println(err)
+	//Output:
+	//invalid parameter of distribution: Gamma(shape=-1, scale=1)
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,70 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/goark/mt/v2"
+)
+
+// Exponential is the exponential distribution with rate lambda.
+type Exponential struct {
+	lambda float64
+}
+
+var _ Continuous = (*Exponential)(nil) //Exponential is compatible with Continuous interface
+
+// NewExponential returns new Exponential instance with rate lambda (> 0).
+func NewExponential(lambda float64) (*Exponential, error) {
+	if !isPositive(lambda) {
+		return nil, fmt.Errorf("%w: Exponential(lambda=%v)", ErrInvalidParameter, lambda)
+	}
+	return &Exponential{lambda: lambda}, nil
+}
+
+// Rand generates a random variate by the inversion method (-log(U) / lambda, U on (0,1)).
+func (d *Exponential) Rand(src mt.Source) float64 {
+	if d == nil || src == nil {
+		return math.NaN()
+	}
+	return -math.Log(uniformOpen(src)) / d.lambda
+}
+
+// Mean returns the mean of the distribution.
+func (d *Exponential) Mean() float64 {
+	if d == nil {
+		return math.NaN()
+	}
+	return 1 / d.lambda
+}
+
+// Variance returns the variance of the distribution.
+func (d *Exponential) Variance() float64 {
+	if d == nil {
+		return math.NaN()
+	}
+	return 1 / (d.lambda * d.lambda)
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
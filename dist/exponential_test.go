@@ -0,0 +1,64 @@
+package dist
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestExponential(t *testing.T) {
+	src := newSource()
+	for _, lambda := range []float64{0.1, 1, 25} {
+		d, err := NewExponential(lambda)
+		if err != nil {
+			t.Errorf("NewExponential() is \"%v\", want nil.", err)
+			continue
+		}
+		name := fmt.Sprintf("Exponential(%v)", lambda)
+		xs := sampling(d, src)
+		checkMoments(t, name, d, xs, 0.03)
+		checkKS(t, name, xs, func(x float64) float64 { return -math.Expm1(-lambda * x) })
+	}
+}
+
+func TestExponentialErr(t *testing.T) {
+	for _, lambda := range []float64{0, -1, math.Inf(1), math.NaN()} {
+		if _, err := NewExponential(lambda); !errors.Is(err, ErrInvalidParameter) {
+			t.Errorf("NewExponential(%v) is \"%v\", want \"%v\".", lambda, err, ErrInvalidParameter)
+		}
+	}
+}
+
+func TestExponentialNil(t *testing.T) {
+	d := (*Exponential)(nil)
+	if r := d.Rand(newSource()); !math.IsNaN(r) {
+		t.Errorf("<nil>.Rand() = %v, want NaN.", r)
+	}
+	if m, v := d.Mean(), d.Variance(); !math.IsNaN(m) || !math.IsNaN(v) {
+		t.Errorf("<nil>.Mean(), Variance() = %v, %v, want NaN, NaN.", m, v)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,138 @@
+package dist
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestGamma(t *testing.T) {
+	testCases := []struct {
+		shape, scale float64
+	}{
+		{shape: 0.3, scale: 1},
+		{shape: 1, scale: 2},
+		{shape: 2.5, scale: 0.5},
+		{shape: 9, scale: 3},
+	}
+	src := newSource()
+	for _, tc := range testCases {
+		d, err := NewGamma(tc.shape, tc.scale)
+		if err != nil {
+			t.Errorf("NewGamma() is \"%v\", want nil.", err)
+			continue
+		}
+		name := fmt.Sprintf("Gamma(%v, %v)", tc.shape, tc.scale)
+		xs := sampling(d, src)
+		checkMoments(t, name, d, xs, 0.05)
+		checkKS(t, name, xs, gammaCDF(tc.shape, tc.scale))
+	}
+}
+
+func TestChiSquared(t *testing.T) {
+	src := newSource()
+	for _, k := range []float64{1, 3, 10} {
+		d, err := NewChiSquared(k)
+		if err != nil {
+			t.Errorf("NewChiSquared() is \"%v\", want nil.", err)
+			continue
+		}
+		name := fmt.Sprintf("ChiSquared(%v)", k)
+		xs := sampling(d, src)
+		checkMoments(t, name, d, xs, 0.05)
+		checkKS(t, name, xs, gammaCDF(k/2, 2))
+	}
+}
+
+func TestBeta(t *testing.T) {
+	testCases := []struct {
+		alpha, beta float64
+		cdf         func(float64) float64
+	}{
+		{alpha: 0.5, beta: 0.5, cdf: func(x float64) float64 { return 2 * math.Asin(math.Sqrt(x)) / math.Pi }},
+		{alpha: 1, beta: 1, cdf: func(x float64) float64 { return x }},
+		{alpha: 2, beta: 5, cdf: func(x float64) float64 {
+			// I_x(2, 5) = sum_{j=2}^{6} C(6, j) x^j (1-x)^(6-j)
+			c := []float64{1, 6, 15, 20, 15, 6, 1}
+			sum := 0.0
+			for j := 2; j <= 6; j++ {
+				sum += c[j] * math.Pow(x, float64(j)) * math.Pow(1-x, float64(6-j))
+			}
+			return sum
+		}},
+	}
+	src := newSource()
+	for _, tc := range testCases {
+		d, err := NewBeta(tc.alpha, tc.beta)
+		if err != nil {
+			t.Errorf("NewBeta() is \"%v\", want nil.", err)
+			continue
+		}
+		name := fmt.Sprintf("Beta(%v, %v)", tc.alpha, tc.beta)
+		xs := sampling(d, src)
+		checkMoments(t, name, d, xs, 0.03)
+		checkKS(t, name, xs, tc.cdf)
+	}
+	d, _ := NewBeta(0.001, 0.001)
+	for i := 0; i < 1000; i++ {
+		if r := d.Rand(src); math.IsNaN(r) || r < 0 || r > 1 {
+			t.Errorf("Beta(0.001, 0.001).Rand() = %v, want [0, 1].", r)
+			break
+		}
+	}
+}
+
+func TestGammaErr(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func() error
+	}{
+		{name: "Gamma(0, 1)", f: func() error { _, err := NewGamma(0, 1); return err }},
+		{name: "Gamma(1, -1)", f: func() error { _, err := NewGamma(1, -1); return err }},
+		{name: "Gamma(NaN, 1)", f: func() error { _, err := NewGamma(math.NaN(), 1); return err }},
+		{name: "ChiSquared(0)", f: func() error { _, err := NewChiSquared(0); return err }},
+		{name: "ChiSquared(+Inf)", f: func() error { _, err := NewChiSquared(math.Inf(1)); return err }},
+		{name: "Beta(0, 1)", f: func() error { _, err := NewBeta(0, 1); return err }},
+		{name: "Beta(1, NaN)", f: func() error { _, err := NewBeta(1, math.NaN()); return err }},
+	}
+	for _, tc := range testCases {
+		if err := tc.f(); !errors.Is(err, ErrInvalidParameter) {
+			t.Errorf("New%s is \"%v\", want \"%v\".", tc.name, err, ErrInvalidParameter)
+		}
+	}
+}
+
+func TestGammaNil(t *testing.T) {
+	for _, d := range []Continuous{(*Gamma)(nil), (*ChiSquared)(nil), (*Beta)(nil)} {
+		if r := d.Rand(newSource()); !math.IsNaN(r) {
+			t.Errorf("<nil>.Rand() = %v, want NaN.", r)
+		}
+		if m, v := d.Mean(), d.Variance(); !math.IsNaN(m) || !math.IsNaN(v) {
+			t.Errorf("<nil>.Mean(), Variance() = %v, %v, want NaN, NaN.", m, v)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
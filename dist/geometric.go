@@ -0,0 +1,122 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/goark/mt/v2"
+)
+
+// Geometric is the geometric distribution; the number of failures before the first success with probability p.
+type Geometric struct {
+	p float64
+}
+
+var _ Discrete = (*Geometric)(nil) //Geometric is compatible with Discrete interface
+
+// NewGeometric returns new Geometric instance with probability p (on (0,1]-interval).
+func NewGeometric(p float64) (*Geometric, error) {
+	if !(p > 0 && p <= 1) {
+		return nil, fmt.Errorf("%w: Geometric(p=%v)", ErrInvalidParameter, p)
+	}
+	return &Geometric{p: p}, nil
+}
+
+// Rand generates a random variate by the inversion method (floor(log(U) / log(1-p)), U on (0,1)).
+func (d *Geometric) Rand(src mt.Source) int {
+	if d == nil || src == nil {
+		return 0
+	}
+	if d.p == 1 {
+		return 0
+	}
+	x := math.Floor(math.Log(uniformOpen(src)) / math.Log1p(-d.p))
+	if x >= math.MaxInt {
+		return math.MaxInt
+	}
+	return int(x)
+}
+
+// Mean returns the mean of the distribution.
+func (d *Geometric) Mean() float64 {
+	if d == nil {
+		return math.NaN()
+	}
+	return (1 - d.p) / d.p
+}
+
+// Variance returns the variance of the distribution.
+func (d *Geometric) Variance() float64 {
+	if d == nil {
+		return math.NaN()
+	}
+	return (1 - d.p) / (d.p * d.p)
+}
+
+// NegativeBinomial is the negative binomial distribution;
+// the number of failures before r-th success with probability p.
+// r may be a non-integer.
+type NegativeBinomial struct {
+	r, p float64
+}
+
+var _ Discrete = (*NegativeBinomial)(nil) //NegativeBinomial is compatible with Discrete interface
+
+// NewNegativeBinomial returns new NegativeBinomial instance with r (> 0) and probability p (on (0,1]-interval).
+func NewNegativeBinomial(r, p float64) (*NegativeBinomial, error) {
+	if !isPositive(r) || !(p > 0 && p <= 1) {
+		return nil, fmt.Errorf("%w: NegativeBinomial(r=%v, p=%v)", ErrInvalidParameter, r, p)
+	}
+	return &NegativeBinomial{r: r, p: p}, nil
+}
+
+// Rand generates a random variate as the gamma-Poisson mixture; Poisson(Y), Y ~ Gamma(r, (1-p)/p).
+func (d *NegativeBinomial) Rand(src mt.Source) int {
+	if d == nil || src == nil {
+		return 0
+	}
+	if d.p == 1 {
+		return 0
+	}
+	lambda := stdGamma(src, d.r) * (1 - d.p) / d.p
+	return poisson(src, math.Min(lambda, maxPoissonLambda))
+}
+
+// Mean returns the mean of the distribution.
+func (d *NegativeBinomial) Mean() float64 {
+	if d == nil {
+		return math.NaN()
+	}
+	return d.r * (1 - d.p) / d.p
+}
+
+// Variance returns the variance of the distribution.
+func (d *NegativeBinomial) Variance() float64 {
+	if d == nil {
+		return math.NaN()
+	}
+	return d.r * (1 - d.p) / (d.p * d.p)
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
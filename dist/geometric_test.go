@@ -0,0 +1,118 @@
+package dist
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestGeometric(t *testing.T) {
+	src := newSource()
+	for _, p := range []float64{0.01, 0.2, 0.9} {
+		d, err := NewGeometric(p)
+		if err != nil {
+			t.Errorf("NewGeometric() is \"%v\", want nil.", err)
+			continue
+		}
+		name := fmt.Sprintf("Geometric(%v)", p)
+		xs := samplingDiscrete(d, src)
+		checkMomentsDiscrete(t, name, d, xs, 0.05)
+		checkChiSquare(t, name, xs, d.Mean(), func(k int) float64 {
+			if k < 0 {
+				return 0
+			}
+			return p * math.Pow(1-p, float64(k))
+		})
+	}
+	d, _ := NewGeometric(1)
+	if r := d.Rand(src); r != 0 {
+		t.Errorf("Geometric(1).Rand() = %v, want %v.", r, 0)
+	}
+}
+
+func TestNegativeBinomial(t *testing.T) {
+	testCases := []struct {
+		r, p float64
+	}{
+		{r: 1, p: 0.3},
+		{r: 2.5, p: 0.3},
+		{r: 10, p: 0.05},
+	}
+	src := newSource()
+	for _, tc := range testCases {
+		d, err := NewNegativeBinomial(tc.r, tc.p)
+		if err != nil {
+			t.Errorf("NewNegativeBinomial() is \"%v\", want nil.", err)
+			continue
+		}
+		name := fmt.Sprintf("NegativeBinomial(%v, %v)", tc.r, tc.p)
+		xs := samplingDiscrete(d, src)
+		checkMomentsDiscrete(t, name, d, xs, 0.05)
+		lgr, _ := math.Lgamma(tc.r)
+		checkChiSquare(t, name, xs, d.Mean(), func(k int) float64 {
+			if k < 0 {
+				return 0
+			}
+			lgk, _ := math.Lgamma(float64(k) + tc.r)
+			lgk1, _ := math.Lgamma(float64(k) + 1)
+			return math.Exp(lgk - lgk1 - lgr + tc.r*math.Log(tc.p) + float64(k)*math.Log1p(-tc.p))
+		})
+	}
+	d, _ := NewNegativeBinomial(3, 1)
+	if r := d.Rand(src); r != 0 {
+		t.Errorf("NegativeBinomial(3, 1).Rand() = %v, want %v.", r, 0)
+	}
+}
+
+func TestGeometricErr(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func() error
+	}{
+		{name: "Geometric(0)", f: func() error { _, err := NewGeometric(0); return err }},
+		{name: "Geometric(1.1)", f: func() error { _, err := NewGeometric(1.1); return err }},
+		{name: "Geometric(NaN)", f: func() error { _, err := NewGeometric(math.NaN()); return err }},
+		{name: "NegativeBinomial(0, 0.5)", f: func() error { _, err := NewNegativeBinomial(0, 0.5); return err }},
+		{name: "NegativeBinomial(1, 0)", f: func() error { _, err := NewNegativeBinomial(1, 0); return err }},
+	}
+	for _, tc := range testCases {
+		if err := tc.f(); !errors.Is(err, ErrInvalidParameter) {
+			t.Errorf("New%s is \"%v\", want \"%v\".", tc.name, err, ErrInvalidParameter)
+		}
+	}
+}
+
+func TestGeometricNil(t *testing.T) {
+	for _, d := range []Discrete{(*Geometric)(nil), (*NegativeBinomial)(nil)} {
+		if r := d.Rand(newSource()); r != 0 {
+			t.Errorf("<nil>.Rand() = %v, want %v.", r, 0)
+		}
+		if m, v := d.Mean(), d.Variance(); !math.IsNaN(m) || !math.IsNaN(v) {
+			t.Errorf("<nil>.Mean(), Variance() = %v, %v, want NaN, NaN.", m, v)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
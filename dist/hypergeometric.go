@@ -0,0 +1,121 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/goark/mt/v2"
+)
+
+// Hypergeometric is the hypergeometric distribution;
+// the number of successes in n draws without replacement from a population of size total that contains k successes.
+type Hypergeometric struct {
+	total, k, n int
+}
+
+var _ Discrete = (*Hypergeometric)(nil) //Hypergeometric is compatible with Discrete interface
+
+// NewHypergeometric returns new Hypergeometric instance with population size total, number of successes k
+// and number of draws n (0 <= k <= total, 0 <= n <= total).
+func NewHypergeometric(total, k, n int) (*Hypergeometric, error) {
+	if total < 0 || k < 0 || k > total || n < 0 || n > total {
+		return nil, fmt.Errorf("%w: Hypergeometric(total=%v, k=%v, n=%v)", ErrInvalidParameter, total, k, n)
+	}
+	return &Hypergeometric{total: total, k: k, n: n}, nil
+}
+
+// Rand generates a random variate by the inversion method with chop-down search from the mode.
+func (d *Hypergeometric) Rand(src mt.Source) int {
+	if d == nil || src == nil {
+		return 0
+	}
+	lo := max(0, d.n+d.k-d.total)
+	hi := min(d.n, d.k)
+	if lo == hi {
+		return lo
+	}
+	nf, kf, tf := float64(d.n), float64(d.k), float64(d.total)
+	mode := int(math.Floor((nf + 1) * (kf + 1) / (tf + 2)))
+	mode = min(max(mode, lo), hi)
+	pmode := math.Exp(logChoose(d.k, mode) + logChoose(d.total-d.k, d.n-mode) - logChoose(d.total, d.n))
+	// ratio of probabilities p(x+1)/p(x)
+	ratio := func(x int) float64 {
+		return float64(d.k-x) * float64(d.n-x) / (float64(x+1) * float64(d.total-d.k-d.n+x+1))
+	}
+	for {
+		u := uniformClosedOpen(src) - pmode
+		if u < 0 {
+			return mode
+		}
+		l, h := mode, mode
+		pl, ph := pmode, pmode
+		for l > lo || h < hi {
+			if h < hi {
+				ph *= ratio(h)
+				h++
+				if u -= ph; u < 0 {
+					return h
+				}
+			}
+			if l > lo {
+				pl /= ratio(l - 1)
+				l--
+				if u -= pl; u < 0 {
+					return l
+				}
+			}
+		}
+		//rounding error; retry
+	}
+}
+
+// Mean returns the mean of the distribution.
+func (d *Hypergeometric) Mean() float64 {
+	if d == nil || d.total == 0 {
+		return math.NaN()
+	}
+	return float64(d.n) * float64(d.k) / float64(d.total)
+}
+
+// Variance returns the variance of the distribution.
+func (d *Hypergeometric) Variance() float64 {
+	if d == nil || d.total == 0 {
+		return math.NaN()
+	}
+	if d.total == 1 {
+		return 0
+	}
+	nf, kf, tf := float64(d.n), float64(d.k), float64(d.total)
+	return nf * (kf / tf) * ((tf - kf) / tf) * ((tf - nf) / (tf - 1))
+}
+
+// logChoose returns log(C(n, k)).
+func logChoose(n, k int) float64 {
+	a, _ := math.Lgamma(float64(n + 1))
+	b, _ := math.Lgamma(float64(k + 1))
+	c, _ := math.Lgamma(float64(n - k + 1))
+	return a - b - c
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,98 @@
+package dist
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestHypergeometric(t *testing.T) {
+	testCases := []struct {
+		total, k, n int
+	}{
+		{total: 50, k: 20, n: 10},
+		{total: 50, k: 45, n: 40},
+		{total: 100, k: 2, n: 60},
+		{total: 10000, k: 4000, n: 3000},
+	}
+	src := newSource()
+	for _, tc := range testCases {
+		d, err := NewHypergeometric(tc.total, tc.k, tc.n)
+		if err != nil {
+			t.Errorf("NewHypergeometric() is \"%v\", want nil.", err)
+			continue
+		}
+		name := fmt.Sprintf("Hypergeometric(%v, %v, %v)", tc.total, tc.k, tc.n)
+		xs := samplingDiscrete(d, src)
+		checkMomentsDiscrete(t, name, d, xs, 0.03)
+		checkChiSquare(t, name, xs, d.Mean(), func(x int) float64 {
+			if x < 0 || x > tc.k || tc.n-x < 0 || tc.n-x > tc.total-tc.k {
+				return 0
+			}
+			return math.Exp(logChoose(tc.k, x) + logChoose(tc.total-tc.k, tc.n-x) - logChoose(tc.total, tc.n))
+		})
+	}
+	for _, tc := range []struct {
+		total, k, n int
+		res         int
+	}{
+		{total: 0, k: 0, n: 0, res: 0},
+		{total: 10, k: 10, n: 3, res: 3},
+		{total: 10, k: 0, n: 3, res: 0},
+		{total: 10, k: 4, n: 10, res: 4},
+	} {
+		d, _ := NewHypergeometric(tc.total, tc.k, tc.n)
+		if r := d.Rand(src); r != tc.res {
+			t.Errorf("Hypergeometric(%v, %v, %v).Rand() = %v, want %v.", tc.total, tc.k, tc.n, r, tc.res)
+		}
+	}
+}
+
+func TestHypergeometricErr(t *testing.T) {
+	for _, tc := range []struct {
+		total, k, n int
+	}{
+		{total: -1, k: 0, n: 0},
+		{total: 10, k: 11, n: 1},
+		{total: 10, k: -1, n: 1},
+		{total: 10, k: 1, n: 11},
+	} {
+		if _, err := NewHypergeometric(tc.total, tc.k, tc.n); !errors.Is(err, ErrInvalidParameter) {
+			t.Errorf("NewHypergeometric(%v, %v, %v) is \"%v\", want \"%v\".", tc.total, tc.k, tc.n, err, ErrInvalidParameter)
+		}
+	}
+}
+
+func TestHypergeometricNil(t *testing.T) {
+	d := (*Hypergeometric)(nil)
+	if r := d.Rand(newSource()); r != 0 {
+		t.Errorf("<nil>.Rand() = %v, want %v.", r, 0)
+	}
+	if m, v := d.Mean(), d.Variance(); !math.IsNaN(m) || !math.IsNaN(v) {
+		t.Errorf("<nil>.Mean(), Variance() = %v, %v, want NaN, NaN.", m, v)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
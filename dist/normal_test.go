@@ -0,0 +1,147 @@
+package dist
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestNormal(t *testing.T) {
+	testCases := []struct {
+		mu, sigma float64
+	}{
+		{mu: 0, sigma: 1},
+		{mu: -3, sigma: 0.5},
+		{mu: 100, sigma: 15},
+	}
+	src := newSource()
+	for _, tc := range testCases {
+		d, err := NewNormal(tc.mu, tc.sigma)
+		if err != nil {
+			t.Errorf("NewNormal() is \"%v\", want nil.", err)
+			continue
+		}
+		name := fmt.Sprintf("Normal(%v, %v)", tc.mu, tc.sigma)
+		xs := sampling(d, src)
+		checkMoments(t, name, d, xs, 0.03)
+		checkKS(t, name, xs, normalCDF(tc.mu, tc.sigma))
+	}
+}
+
+func TestLogNormal(t *testing.T) {
+	testCases := []struct {
+		mu, sigma float64
+	}{
+		{mu: 0, sigma: 0.25},
+		{mu: 1, sigma: 0.5},
+	}
+	src := newSource()
+	for _, tc := range testCases {
+		d, err := NewLogNormal(tc.mu, tc.sigma)
+		if err != nil {
+			t.Errorf("NewLogNormal() is \"%v\", want nil.", err)
+			continue
+		}
+		name := fmt.Sprintf("LogNormal(%v, %v)", tc.mu, tc.sigma)
+		xs := sampling(d, src)
+		checkMoments(t, name, d, xs, 0.05)
+		cdf := normalCDF(tc.mu, tc.sigma)
+		checkKS(t, name, xs, func(x float64) float64 { return cdf(math.Log(x)) })
+	}
+}
+
+func TestStudentT(t *testing.T) {
+	testCases := []struct {
+		nu  float64
+		cdf func(float64) float64
+	}{
+		{nu: 1, cdf: func(x float64) float64 { return 0.5 + math.Atan(x)/math.Pi }},
+		{nu: 2, cdf: func(x float64) float64 { return 0.5 + x/(2*math.Sqrt(2+x*x)) }},
+		{nu: 3, cdf: func(x float64) float64 {
+			y := x / math.Sqrt(3)
+			return 0.5 + (y/(1+y*y)+math.Atan(y))/math.Pi
+		}},
+	}
+	src := newSource()
+	for _, tc := range testCases {
+		d, err := NewStudentT(tc.nu)
+		if err != nil {
+			t.Errorf("NewStudentT() is \"%v\", want nil.", err)
+			continue
+		}
+		name := fmt.Sprintf("StudentT(%v)", tc.nu)
+		checkKS(t, name, sampling(d, src), tc.cdf)
+	}
+	d, _ := NewStudentT(8)
+	checkMoments(t, "StudentT(8)", d, sampling(d, src), 0.05)
+	if m := d.Mean(); m != 0 {
+		t.Errorf("StudentT(8).Mean() = %v, want %v.", m, 0)
+	}
+	d, _ = NewStudentT(1)
+	if m, v := d.Mean(), d.Variance(); !math.IsNaN(m) || !math.IsNaN(v) {
+		t.Errorf("StudentT(1).Mean(), Variance() = %v, %v, want NaN, NaN.", m, v)
+	}
+	d, _ = NewStudentT(2)
+	if v := d.Variance(); !math.IsInf(v, 1) {
+		t.Errorf("StudentT(2).Variance() = %v, want +Inf.", v)
+	}
+}
+
+func TestNormalErr(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func() error
+	}{
+		{name: "Normal(0, 0)", f: func() error { _, err := NewNormal(0, 0); return err }},
+		{name: "Normal(NaN, 1)", f: func() error { _, err := NewNormal(math.NaN(), 1); return err }},
+		{name: "Normal(0, +Inf)", f: func() error { _, err := NewNormal(0, math.Inf(1)); return err }},
+		{name: "LogNormal(0, -1)", f: func() error { _, err := NewLogNormal(0, -1); return err }},
+		{name: "LogNormal(-Inf, 1)", f: func() error { _, err := NewLogNormal(math.Inf(-1), 1); return err }},
+		{name: "StudentT(0)", f: func() error { _, err := NewStudentT(0); return err }},
+		{name: "StudentT(NaN)", f: func() error { _, err := NewStudentT(math.NaN()); return err }},
+	}
+	for _, tc := range testCases {
+		if err := tc.f(); !errors.Is(err, ErrInvalidParameter) {
+			t.Errorf("New%s is \"%v\", want \"%v\".", tc.name, err, ErrInvalidParameter)
+		}
+	}
+}
+
+func TestNormalNil(t *testing.T) {
+	for _, d := range []Continuous{(*Normal)(nil), (*LogNormal)(nil), (*StudentT)(nil)} {
+		if r := d.Rand(newSource()); !math.IsNaN(r) {
+			t.Errorf("<nil>.Rand() = %v, want NaN.", r)
+		}
+		if m, v := d.Mean(), d.Variance(); !math.IsNaN(m) || !math.IsNaN(v) {
+			t.Errorf("<nil>.Mean(), Variance() = %v, %v, want NaN, NaN.", m, v)
+		}
+	}
+	d, _ := NewNormal(0, 1)
+	if r := d.Rand(nil); !math.IsNaN(r) {
+		t.Errorf("Normal.Rand(nil) = %v, want NaN.", r)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,128 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/goark/mt/v2"
+)
+
+const maxPoissonLambda = 1 << 52 //upper limit of lambda of Poisson distribution
+
+// Poisson is the Poisson distribution with mean lambda.
+type Poisson struct {
+	lambda float64
+}
+
+var _ Discrete = (*Poisson)(nil) //Poisson is compatible with Discrete interface
+
+// NewPoisson returns new Poisson instance with mean lambda (0 <= lambda <= 2^52).
+func NewPoisson(lambda float64) (*Poisson, error) {
+	if !(lambda >= 0 && lambda <= maxPoissonLambda) {
+		return nil, fmt.Errorf("%w: Poisson(lambda=%v)", ErrInvalidParameter, lambda)
+	}
+	return &Poisson{lambda: lambda}, nil
+}
+
+// Rand generates a random variate.
+// If lambda < 10, it uses the inversion method by sequential search.
+// Otherwise it uses the transformed rejection method with squeeze (PTRS)
+// (W. Hormann, "The transformed rejection method for generating Poisson random variables",
+// Insurance: Mathematics and Economics 12 (1993) 39-45).
+func (d *Poisson) Rand(src mt.Source) int {
+	if d == nil || src == nil {
+		return 0
+	}
+	return poisson(src, d.lambda)
+}
+
+// Mean returns the mean of the distribution.
+func (d *Poisson) Mean() float64 {
+	if d == nil {
+		return math.NaN()
+	}
+	return d.lambda
+}
+
+// Variance returns the variance of the distribution.
+func (d *Poisson) Variance() float64 {
+	if d == nil {
+		return math.NaN()
+	}
+	return d.lambda
+}
+
+func poisson(src mt.Source, lambda float64) int {
+	switch {
+	case lambda == 0:
+		return 0
+	case lambda < 10:
+		return poissonInversion(src, lambda)
+	default:
+		return poissonPTRS(src, lambda)
+	}
+}
+
+func poissonInversion(src mt.Source, lambda float64) int {
+	for {
+		p := math.Exp(-lambda)
+		s := p
+		u := uniformClosedOpen(src)
+		for x := 0; x < 1000; x++ {
+			if u < s {
+				return x
+			}
+			p *= lambda / float64(x+1)
+			s += p
+		}
+		//rounding error; retry
+	}
+}
+
+func poissonPTRS(src mt.Source, lambda float64) int {
+	slam := math.Sqrt(lambda)
+	loglam := math.Log(lambda)
+	b := 0.931 + 2.53*slam
+	a := -0.059 + 0.02483*b
+	invalpha := 1.1239 + 1.1328/(b-3.4)
+	vr := 0.9277 - 3.6224/(b-2)
+	for {
+		u := uniformClosedOpen(src) - 0.5
+		v := uniformOpen(src)
+		us := 0.5 - math.Abs(u)
+		k := math.Floor((2*a/us+b)*u + lambda + 0.43)
+		if us >= 0.07 && v <= vr {
+			return int(k)
+		}
+		if k < 0 || (us < 0.013 && v > us) {
+			continue
+		}
+		lg, _ := math.Lgamma(k + 1)
+		if math.Log(v)+math.Log(invalpha)-math.Log(a/(us*us)+b) <= -lambda+k*loglam-lg {
+			return int(k)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,78 @@
+package dist
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func poissonPMF(lambda float64) func(int) float64 {
+	return func(k int) float64 {
+		if k < 0 {
+			return 0
+		}
+		lg, _ := math.Lgamma(float64(k + 1))
+		return math.Exp(float64(k)*math.Log(lambda) - lambda - lg)
+	}
+}
+
+func TestPoisson(t *testing.T) {
+	src := newSource()
+	for _, lambda := range []float64{0.5, 5, 9.9, 10, 50, 1000, 1e6} {
+		d, err := NewPoisson(lambda)
+		if err != nil {
+			t.Errorf("NewPoisson() is \"%v\", want nil.", err)
+			continue
+		}
+		name := fmt.Sprintf("Poisson(%v)", lambda)
+		xs := samplingDiscrete(d, src)
+		checkMomentsDiscrete(t, name, d, xs, 0.03)
+		checkChiSquare(t, name, xs, lambda, poissonPMF(lambda))
+	}
+	d, _ := NewPoisson(0)
+	if r := d.Rand(src); r != 0 {
+		t.Errorf("Poisson(0).Rand() = %v, want %v.", r, 0)
+	}
+}
+
+func TestPoissonErr(t *testing.T) {
+	for _, lambda := range []float64{-1, math.Inf(1), math.NaN(), 1e300} {
+		if _, err := NewPoisson(lambda); !errors.Is(err, ErrInvalidParameter) {
+			t.Errorf("NewPoisson(%v) is \"%v\", want \"%v\".", lambda, err, ErrInvalidParameter)
+		}
+	}
+}
+
+func TestPoissonNil(t *testing.T) {
+	d := (*Poisson)(nil)
+	if r := d.Rand(newSource()); r != 0 {
+		t.Errorf("<nil>.Rand() = %v, want %v.", r, 0)
+	}
+	if m, v := d.Mean(), d.Variance(); !math.IsNaN(m) || !math.IsNaN(v) {
+		t.Errorf("<nil>.Mean(), Variance() = %v, %v, want NaN, NaN.", m, v)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,353 @@
+package dsfmt
+
+import (
+	"math"
+
+	"github.com/goark/mt/v2"
+)
+
+// parameters of dSFMT19937 (dSFMT-params19937.h)
+const (
+	mexp     = 19937
+	nn       = (mexp-128)/104 + 1 // size of state vector in 128-bit words (without lung)
+	nn64     = nn * 2             // size of state vector in 64-bit words (without lung)
+	pos1     = 117
+	sl1      = 19
+	sr       = 12
+	msk1     = 0x000ffafffffffb3f
+	msk2     = 0x000ffdfffc90fffd
+	fix1     = 0x90014964b32f4329
+	fix2     = 0x3b8d12ac548a7c7a
+	pcv1     = 0x3d84e1ac0dc82880
+	pcv2     = 0x0000000000000001
+	lowMask  = 0x000fffffffffffff
+	highMask = 0x3ff0000000000000 // exponent of [1,2)
+)
+
+// IDString is the identification string of dSFMT19937 (same as dsfmt_get_idstring function).
+const IDString = "dSFMT2-19937:117-19:ffafffffffb3f-ffdfffc90fffd"
+
+// Source is a source of random numbers (dSFMT19937; double precision SIMD-oriented Fast Mersenne Twister).
+// It generates IEEE 754 double precision numbers on [1,2)-real-interval natively.
+type Source struct {
+	state [nn64 + 2]uint64 //The array for the state vector (pairs of 64-bit words are 128-bit words; the last one is lung)
+	idx   int              //index of state in 64-bit words; idx>=nn64 means state must be regenerated
+	init  bool             //true if state is initialized
+}
+
+var _ mt.Source = (*Source)(nil) //Source is compatible with mt.Source interface
+var _ mt.Seeder = (*Source)(nil) //Source is compatible with mt.Seeder interface
+
+// New returns a new pseudo-random source seeded with the given value.
+// Only the lower 32 bits of seed are used (same as dsfmt_init_gen_rand function).
+func New(seed int64) *Source {
+	rng := &Source{}
+	rng.Seed(seed)
+	return rng
+}
+
+// NewWithArray returns a new pseudo-random source seeded with the given values.
+// Only the lower 32 bits of each seed are used (same as dsfmt_init_by_array function).
+func NewWithArray(seeds []uint64) *Source {
+	rng := &Source{}
+	rng.SeedArray(seeds)
+	return rng
+}
+
+// MinArraySize returns the minimum size of arrays for fast bulk generation (see FillFloat64s method).
+func (s *Source) MinArraySize() int {
+	return nn64
+}
+
+// Seed initializes Source with a seed
+func (s *Source) Seed(seed int64) {
+	if s == nil {
+		return
+	}
+	st := make([]uint32, len(s.state)*2)
+	st[0] = uint32(seed)
+	for i := 1; i < len(st); i++ {
+		st[i] = 1812433253*(st[i-1]^(st[i-1]>>30)) + uint32(i)
+	}
+	s.setState(st)
+}
+
+// SeedArray initializes Source with seeds array
+func (s *Source) SeedArray(seeds []uint64) {
+	if s == nil {
+		return
+	}
+	const (
+		size = (nn + 1) * 4
+		lag  = 11 // size >= 623
+		mid  = (size - lag) / 2
+	)
+	st := make([]uint32, size)
+	for i := range st {
+		st[i] = 0x8b8b8b8b
+	}
+	count := size
+	if len(seeds)+1 > size {
+		count = len(seeds) + 1
+	}
+	r := func1(st[0] ^ st[mid] ^ st[size-1])
+	st[mid] += r
+	r += uint32(len(seeds))
+	st[mid+lag] += r
+	st[0] = r
+	count--
+	i := 1
+	for j := 0; j < count; j++ {
+		r = func1(st[i] ^ st[(i+mid)%size] ^ st[(i+size-1)%size])
+		st[(i+mid)%size] += r
+		if j < len(seeds) {
+			r += uint32(seeds[j])
+		}
+		r += uint32(i)
+		st[(i+mid+lag)%size] += r
+		st[i] = r
+		i = (i + 1) % size
+	}
+	for j := 0; j < size; j++ {
+		r = func2(st[i] + st[(i+mid)%size] + st[(i+size-1)%size])
+		st[(i+mid)%size] ^= r
+		r -= uint32(i)
+		st[(i+mid+lag)%size] ^= r
+		st[i] = r
+		i = (i + 1) % size
+	}
+	s.setState(st)
+}
+
+func func1(x uint32) uint32 {
+	return (x ^ (x >> 27)) * 1664525
+}
+
+func func2(x uint32) uint32 {
+	return (x ^ (x >> 27)) * 1566083941
+}
+
+// setState sets the state vector in 32-bit words, masks it to [1,2) (initial_mask function),
+// and certificates the period.
+func (s *Source) setState(st []uint32) {
+	for i := range s.state {
+		s.state[i] = uint64(st[2*i+1])<<32 | uint64(st[2*i])
+	}
+	for i := 0; i < nn64; i++ {
+		s.state[i] = s.state[i]&lowMask | highMask
+	}
+	s.idx = nn64
+	s.init = true
+	s.certificatePeriod()
+}
+
+// certificatePeriod certificates the period of 2^MEXP-1 (period_certification function).
+func (s *Source) certificatePeriod() {
+	inner := (s.state[nn64] ^ fix1) & pcv1
+	inner ^= (s.state[nn64+1] ^ fix2) & pcv2
+	for i := 32; i > 0; i >>= 1 {
+		inner ^= inner >> i
+	}
+	if inner&1 == 1 {
+		return
+	}
+	s.state[nn64+1] ^= 1 // pcv2&1 == 1
+}
+
+// next returns the next 64-bit word of the state vector (a number on [1,2)-real-interval in IEEE 754 format).
+func (s *Source) next() uint64 {
+	if s.idx >= nn64 || !s.init {
+		if !s.init {
+			s.Seed(5489) // a default initial seed is used
+		}
+		s.generateAll()
+		s.idx = 0
+	}
+	w := s.state[s.idx]
+	s.idx++
+	return w
+}
+
+// Uint32 generates a random number on [0, 2^32-1]-interval
+// (lower 32 bits of the mantissa; same as dsfmt_genrand_uint32 function).
+func (s *Source) Uint32() uint32 {
+	if s == nil {
+		return 0
+	}
+	return uint32(s.next())
+}
+
+// Uint64 generates a random number on [0, 2^64-1]-interval.
+// It is composed of two Uint32 values; the first one is the lower 32 bits.
+func (s *Source) Uint64() uint64 {
+	if s == nil {
+		return 0
+	}
+	lo := s.next() & 0xffffffff
+	return s.next()<<32 | lo
+}
+
+// Float64Close1Open2 generates a random number on [1,2)-real-interval
+// (same as dsfmt_genrand_close1_open2 function).
+func (s *Source) Float64Close1Open2() float64 {
+	if s == nil {
+		return 0.0
+	}
+	return math.Float64frombits(s.next())
+}
+
+// Real generates a random number
+// on [0,1]-real-interval if mode==1,
+// on [0,1)-real-interval if mode==2,
+// on (0,1)-real-interval others
+//
+// Deprecated: use Source.Float64 method instead.
+func (s *Source) Real(mode int) float64 {
+	return s.Float64(mt.ModeInterval(mode))
+}
+
+// Float64 generates a random number on the interval iv with 52-bit resolution.
+// Float64(mt.ClosedOpen), Float64(mt.OpenClosed) and Float64(mt.Open) are same as
+// dsfmt_genrand_close_open, dsfmt_genrand_open_close and dsfmt_genrand_open_open functions.
+func (s *Source) Float64(iv mt.Interval) float64 {
+	if s == nil {
+		return 0.0
+	}
+	w := s.next()
+	switch iv {
+	case mt.Closed:
+		return float64(w&lowMask) * (1.0 / 4503599627370495.0)
+	case mt.ClosedOpen:
+		return math.Float64frombits(w) - 1.0
+	case mt.OpenClosed:
+		return 2.0 - math.Float64frombits(w)
+	default:
+		return math.Float64frombits(w|1) - 1.0
+	}
+}
+
+// FillFloat64s fills buf with random numbers on the interval iv
+// (same as Float64(iv) method called len(buf) times).
+// FillFloat64s(buf, mt.ClosedOpen), FillFloat64s(buf, mt.OpenClosed) and FillFloat64s(buf, mt.Open) are same as
+// dsfmt_fill_array_close_open, dsfmt_fill_array_open_close and dsfmt_fill_array_open_open functions.
+func (s *Source) FillFloat64s(buf []float64, iv mt.Interval) {
+	if s == nil {
+		return
+	}
+	s.FillFloat64sClose1Open2(buf)
+	switch iv {
+	case mt.Closed:
+		for i, f := range buf {
+			buf[i] = float64(math.Float64bits(f)&lowMask) * (1.0 / 4503599627370495.0)
+		}
+	case mt.ClosedOpen:
+		for i := range buf {
+			buf[i] -= 1.0
+		}
+	case mt.OpenClosed:
+		for i, f := range buf {
+			buf[i] = 2.0 - f
+		}
+	default:
+		for i, f := range buf {
+			buf[i] = math.Float64frombits(math.Float64bits(f)|1) - 1.0
+		}
+	}
+}
+
+// FillFloat64sClose1Open2 fills buf with random numbers on [1,2)-real-interval
+// (same as Float64Close1Open2 method called len(buf) times).
+// If the internal state has been consumed and len(buf) >= MinArraySize(), random numbers are generated
+// directly into buf in 128-bit words (same as dsfmt_fill_array_close1_open2 function).
+func (s *Source) FillFloat64sClose1Open2(buf []float64) {
+	if s == nil {
+		return
+	}
+	for len(buf) > 0 && s.init && s.idx < nn64 {
+		buf[0] = math.Float64frombits(s.next())
+		buf = buf[1:]
+	}
+	if n := len(buf) &^ 1; n >= nn64 {
+		if !s.init {
+			s.Seed(5489) // a default initial seed is used
+		}
+		s.generateArray(buf[:n])
+		buf = buf[n:]
+	}
+	for i := range buf {
+		buf[i] = math.Float64frombits(s.next())
+	}
+}
+
+// generateAll regenerates the whole state vector (dsfmt_gen_rand_all function).
+func (s *Source) generateAll() {
+	st := &s.state
+	l0, l1 := st[nn64], st[nn64+1]
+	for i, j := 0, pos1; i < nn; i, j = i+1, j+1 {
+		if j >= nn {
+			j -= nn
+		}
+		st[2*i], st[2*i+1], l0, l1 = recursion(st[2*i], st[2*i+1], st[2*j], st[2*j+1], l0, l1)
+	}
+	st[nn64], st[nn64+1] = l0, l1
+}
+
+// generateArray generates random numbers on [1,2)-real-interval into array directly, and updates the state vector
+// (gen_rand_array_c1o2 function). len(array) must be even and not less than nn64.
+func (s *Source) generateArray(array []float64) {
+	st := &s.state
+	size := len(array) / 2
+	l0, l1 := st[nn64], st[nn64+1]
+	var r0, r1 uint64
+	i := 0
+	for ; i < nn-pos1; i++ {
+		j := i + pos1
+		r0, r1, l0, l1 = recursion(st[2*i], st[2*i+1], st[2*j], st[2*j+1], l0, l1)
+		array[2*i], array[2*i+1] = math.Float64frombits(r0), math.Float64frombits(r1)
+	}
+	for ; i < nn; i++ {
+		j := i + pos1 - nn
+		r0, r1, l0, l1 = recursion(st[2*i], st[2*i+1], math.Float64bits(array[2*j]), math.Float64bits(array[2*j+1]), l0, l1)
+		array[2*i], array[2*i+1] = math.Float64frombits(r0), math.Float64frombits(r1)
+	}
+	for ; i < size; i++ {
+		h, j := i-nn, i+pos1-nn
+		r0, r1, l0, l1 = recursion(math.Float64bits(array[2*h]), math.Float64bits(array[2*h+1]), math.Float64bits(array[2*j]), math.Float64bits(array[2*j+1]), l0, l1)
+		array[2*i], array[2*i+1] = math.Float64frombits(r0), math.Float64frombits(r1)
+	}
+	for k, f := range array[2*(size-nn) : 2*size] {
+		st[k] = math.Float64bits(f)
+	}
+	st[nn64], st[nn64+1] = l0, l1
+	s.idx = nn64
+}
+
+// recursion is the recursion formula of dSFMT (do_recursion function) for 128-bit words a, b and lung.
+// It returns the new 128-bit word and lung.
+func recursion(a0, a1, b0, b1, l0, l1 uint64) (uint64, uint64, uint64, uint64) {
+	l0, l1 = (a0<<sl1)^(l1>>32)^(l1<<32)^b0, (a1<<sl1)^(l0>>32)^(l0<<32)^b1
+	return (l0 >> sr) ^ (l0 & msk1) ^ a0, (l1 >> sr) ^ (l1 & msk2) ^ a1, l0, l1
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,172 @@
+package dsfmt
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/goark/mt/v2"
+)
+
+// first outputs of dSFMT.19937.out.txt (reference output of dSFMT by the original authors)
+var referenceClose1Open2 = []string{"1.030581026769374", "1.213140320067012", "1.299002525016001", "1.381138853044628", "1.863488397063594"}
+
+func TestDSFMT19937(t *testing.T) {
+	s := New(0)
+	for i, res := range referenceClose1Open2 {
+		if r := fmt.Sprintf("%.15f", s.Float64Close1Open2()); r != res {
+			t.Errorf("Source.Float64Close1Open2() [%v] = %v, want %v.", i, r, res)
+		}
+	}
+}
+
+func TestJulia(t *testing.T) {
+	// rand(MersenneTwister(seed)) in Julia (MersenneTwister is seeded by dsfmt_init_by_array function)
+	testCases := []struct {
+		seed uint64
+		res  []float64
+	}{
+		{seed: 0, res: []float64{0.8236475079774124, 0.9103565379264364, 0.16456579813368521, 0.17732884646626457, 0.278880109331201}},
+		{seed: 1234, res: []float64{0.5908446386657102, 0.7667970365022592, 0.5662374165061859, 0.4600853424625171, 0.7940257103317943}},
+	}
+	for _, tc := range testCases {
+		s := NewWithArray([]uint64{tc.seed})
+		for i, res := range tc.res {
+			if r := s.Float64(mt.ClosedOpen); r != res {
+				t.Errorf("Source.Float64() (seed %v) [%v] = %v, want %v.", tc.seed, i, r, res)
+			}
+		}
+	}
+}
+
+func TestFloat64(t *testing.T) {
+	s1, s2 := New(5489), New(5489)
+	for i := 0; i < 10000; i++ {
+		for _, iv := range []mt.Interval{mt.Closed, mt.ClosedOpen, mt.OpenClosed, mt.Open} {
+			w := s2.Float64Close1Open2()
+			f := s1.Float64(iv)
+			var res float64
+			switch iv {
+			case mt.Closed:
+				res = (w - 1.0) * 0x1p52 / (0x1p52 - 1)
+			case mt.ClosedOpen:
+				res = w - 1.0
+			case mt.OpenClosed:
+				res = 2.0 - w
+			default:
+				res = math.Float64frombits(math.Float64bits(w)|1) - 1.0
+			}
+			if math.Abs(f-res) > 0x1p-52 {
+				t.Errorf("Source.Float64(%v) [%v] = %v, want %v.", iv, i, f, res)
+			}
+			if (iv == mt.ClosedOpen || iv == mt.Open) && f >= 1 || (iv == mt.OpenClosed || iv == mt.Open) && f <= 0 {
+				t.Errorf("Source.Float64(%v) [%v] = %v, out of range.", iv, i, f)
+			}
+		}
+	}
+}
+
+func TestUint(t *testing.T) {
+	s1, s2 := New(5489), New(5489)
+	for i := 0; i < 1000; i++ {
+		lo := uint64(s2.Uint32())
+		res := uint64(s2.Uint32())<<32 | lo
+		if r := s1.Uint64(); r != res {
+			t.Errorf("Source.Uint64() [%v] = %v, want %v.", i, r, res)
+		}
+	}
+	s1, s2 = New(5489), New(5489)
+	for i := 0; i < 1000; i++ {
+		if r, res := s1.Uint32(), uint32(math.Float64bits(s2.Float64Close1Open2())); r != res {
+			t.Errorf("Source.Uint32() [%v] = %v, want %v.", i, r, res)
+		}
+	}
+}
+
+func TestFill(t *testing.T) {
+	for _, skip := range []int{0, 1, 2, 5} {
+		for _, size := range []int{0, 1, 7, 382, 383, 1000, 5001} {
+			s1, s2 := New(5489), New(5489)
+			for i := 0; i < skip; i++ {
+				s1.Uint32()
+				s2.Uint32()
+			}
+			buf := make([]float64, size)
+			s1.FillFloat64sClose1Open2(buf)
+			for i, r := range buf {
+				if res := s2.Float64Close1Open2(); r != res {
+					t.Fatalf("Source.FillFloat64sClose1Open2() (skip %v, size %v) [%v] = %v, want %v.", skip, size, i, r, res)
+				}
+			}
+			for _, iv := range []mt.Interval{mt.Closed, mt.ClosedOpen, mt.OpenClosed, mt.Open} {
+				s1.FillFloat64s(buf, iv)
+				for i, r := range buf {
+					if res := s2.Float64(iv); r != res {
+						t.Fatalf("Source.FillFloat64s(%v) (skip %v, size %v) [%v] = %v, want %v.", iv, skip, size, i, r, res)
+					}
+				}
+			}
+			if r, res := s1.Uint64(), s2.Uint64(); r != res {
+				t.Errorf("Source.Uint64() after filling (skip %v, size %v) = %v, want %v.", skip, size, r, res)
+			}
+		}
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	s1, s2 := &Source{}, &Source{}
+	buf := make([]float64, 1000)
+	s2.FillFloat64sClose1Open2(buf)
+	res := New(5489) // a default initial seed is used
+	for i := range buf {
+		want := res.Float64Close1Open2()
+		if r := s1.Float64Close1Open2(); r != want {
+			t.Errorf("<empty>.Float64Close1Open2() [%v] = \"%v\", want \"%v\".", i, r, want)
+		}
+		if buf[i] != want {
+			t.Errorf("<empty>.FillFloat64sClose1Open2() [%v] = \"%v\", want \"%v\".", i, buf[i], want)
+		}
+	}
+}
+
+func TestNil(t *testing.T) {
+	s := (*Source)(nil)
+	s.Seed(1)
+	s.SeedArray(nil)
+	s.FillFloat64s(make([]float64, 1), mt.ClosedOpen)
+	if r := s.Uint32(); r != 0 {
+		t.Errorf("<nil>.Uint32() = \"%v\", want \"%v\".", r, 0)
+	}
+	if r := s.Uint64(); r != 0 {
+		t.Errorf("<nil>.Uint64() = \"%v\", want \"%v\".", r, 0)
+	}
+	if r := s.Real(0); r != 0 {
+		t.Errorf("<nil>.Real() = \"%v\", want \"%v\".", r, 0)
+	}
+	if r := s.Float64Close1Open2(); r != 0 {
+		t.Errorf("<nil>.Float64Close1Open2() = \"%v\", want \"%v\".", r, 0)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
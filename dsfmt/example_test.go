@@ -0,0 +1,53 @@
+package dsfmt_test
+
+import (
+	"fmt"
+
+	"github.com/goark/mt/v2"
+	"github.com/goark/mt/v2/dsfmt"
+)
+
+func ExampleNew() {
+	fmt.Printf("%.15f\n", dsfmt.New(0).Float64Close1Open2())
+	//Output:
+	//1.030581026769374
+}
+
+func ExampleNewWithArray() {
+	// same as rand(MersenneTwister(0)) in Julia
+	fmt.Println(dsfmt.NewWithArray([]uint64{0}).Float64(mt.ClosedOpen))
+	//Output:
+	//0.8236475079774124
+}
+
+func ExampleSource_FillFloat64s() {
+	s := dsfmt.NewWithArray([]uint64{0})
+	buf := make([]float64, 1000) // len(buf) >= s.MinArraySize()
+	s.FillFloat64s(buf, mt.ClosedOpen)
+	fmt.Println(buf[0])
+	//Output:
+	//0.8236475079774124
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,115 @@
+package mt
+
+import (
+	"encoding/binary"
+	"math/rand/v2"
+)
+
+// Do calls f with the source under a single lock, so that f can generate many random numbers
+// without interleaving with other goroutines.
+// f must not call methods of prng (it causes a deadlock). If prng is nil, f is not called.
+func (prng *PRNG) Do(f func(s Source)) {
+	if prng == nil {
+		return
+	}
+	prng.mutex.Lock()
+	defer prng.mutex.Unlock()
+	f(prng.source)
+}
+
+// FillUint64s fills buf with random numbers on [0, 2^64-1]-interval under a single lock.
+func (prng *PRNG) FillUint64s(buf []uint64) {
+	if prng == nil {
+		return
+	}
+	prng.mutex.Lock()
+	fillUint64s(prng.source, buf)
+	prng.mutex.Unlock()
+}
+
+// FillFloat64s fills buf with random numbers on the interval iv under a single lock.
+func (prng *PRNG) FillFloat64s(buf []float64, iv Interval) {
+	if prng == nil {
+		return
+	}
+	prng.mutex.Lock()
+	fillFloat64s(prng.source, buf, iv)
+	prng.mutex.Unlock()
+}
+
+// FillBytes fills buf with random bytes under a single lock.
+// The bytes are the same as the ones read from a new Reader (random numbers in little endian).
+func (prng *PRNG) FillBytes(buf []byte) {
+	if prng == nil {
+		return
+	}
+	prng.mutex.Lock()
+	fillBytes(prng.source, buf)
+	prng.mutex.Unlock()
+}
+
+// fillUint64s fills buf with random numbers from src.
+// If src has FillUint64s method (e.g. *mt19937.Source), it is used.
+func fillUint64s(src rand.Source, buf []uint64) {
+	if s, ok := src.(interface{ FillUint64s([]uint64) }); ok {
+		s.FillUint64s(buf)
+		return
+	}
+	for i := range buf {
+		buf[i] = src.Uint64()
+	}
+}
+
+// fillFloat64s fills buf with random numbers on the interval iv from src.
+// If src has FillFloat64s method with Interval (e.g. *mt19937.Source), it is used.
+func fillFloat64s(src rand.Source, buf []float64, iv Interval) {
+	if s, ok := src.(interface{ FillFloat64s([]float64, Interval) }); ok {
+		s.FillFloat64s(buf, iv)
+		return
+	}
+	for i := range buf {
+		buf[i] = float64Of(src, iv)
+	}
+}
+
+// fillBytes fills buf with random numbers from src in little endian.
+// If len(buf) is not a multiple of 8, the last random number is truncated.
+// If src has FillBytes method (e.g. *mt19937.Source), it is used.
+func fillBytes(src rand.Source, buf []byte) {
+	if s, ok := src.(interface{ FillBytes([]byte) }); ok {
+		s.FillBytes(buf)
+		return
+	}
+	for len(buf) >= 8 {
+		binary.LittleEndian.PutUint64(buf, src.Uint64())
+		buf = buf[8:]
+	}
+	if len(buf) > 0 {
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], src.Uint64())
+		copy(buf, b[:])
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
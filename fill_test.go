@@ -0,0 +1,129 @@
+package mt
+
+import (
+	"bytes"
+	"io"
+	"slices"
+	"testing"
+)
+
+// mockup for test (splitMix with FillUint64s method)
+type fillSource struct {
+	splitMix
+	ct int
+}
+
+func (s *fillSource) FillUint64s(buf []uint64) {
+	s.ct++
+	for i := range buf {
+		buf[i] = s.Uint64()
+	}
+}
+
+func TestFillUint64s(t *testing.T) {
+	prng1 := New(&splitMix{x: 1})
+	prng2 := New(&splitMix{x: 1})
+	buf := make([]uint64, 1000)
+	prng1.FillUint64s(buf)
+	for i, r := range buf {
+		if res := prng2.Uint64(); r != res {
+			t.Errorf("PRNG.FillUint64s(): %v-th value = %v, want %v.", i, r, res)
+			break
+		}
+	}
+	src := &fillSource{splitMix: splitMix{x: 1}}
+	buf2 := make([]uint64, 1000)
+	New(src).FillUint64s(buf2)
+	if src.ct != 1 {
+		t.Errorf("Source.FillUint64s() is called %v times, want %v.", src.ct, 1)
+	}
+	if !slices.Equal(buf, buf2) {
+		t.Error("PRNG.FillUint64s() with FillUint64s method is not the same as without it.")
+	}
+}
+
+func TestFillFloat64s(t *testing.T) {
+	for _, iv := range []Interval{Open, Closed, ClosedOpen, OpenClosed} {
+		prng1 := New(&splitMix{x: 1})
+		buf := make([]float64, 1000)
+		prng1.FillFloat64s(buf, iv)
+		prng2 := New(&splitMix{x: 1})
+		for i, r := range buf {
+			if res := prng2.Float64(iv); r != res {
+				t.Errorf("PRNG.FillFloat64s(%v): %v-th value = %v, want %v.", iv, i, r, res)
+				break
+			}
+		}
+	}
+}
+
+func TestFillBytes(t *testing.T) {
+	for _, n := range []int{0, 1, 7, 8, 9, 1000, 4099} {
+		prng := New(&splitMix{x: 1})
+		buf := make([]byte, n)
+		prng.FillBytes(buf)
+		res := make([]byte, n)
+		if _, err := io.ReadFull(New(&splitMix{x: 1}).NewReader(), res); err != nil {
+			t.Fatalf("Reader.Read() is \"%v\", want nil.", err)
+		}
+		if !bytes.Equal(buf, res) {
+			t.Errorf("PRNG.FillBytes() (n %v) is not the same as Reader.", n)
+		}
+	}
+}
+
+func TestDo(t *testing.T) {
+	prng := New(&splitMix{x: 1})
+	var r uint64
+	prng.Do(func(s Source) {
+		for i := 0; i < 10; i++ {
+			r = s.Uint64()
+		}
+	})
+	res := New(&splitMix{x: 1})
+	for i := 0; i < 9; i++ {
+		_ = res.Uint64()
+	}
+	if v := res.Uint64(); r != v {
+		t.Errorf("PRNG.Do(): 10th value = %v, want %v.", r, v)
+	}
+}
+
+func TestFillNil(t *testing.T) {
+	prng := (*PRNG)(nil)
+	called := false
+	prng.Do(func(Source) { called = true })
+	if called {
+		t.Error("<nil>.Do() calls f.")
+	}
+	buf := []uint64{1}
+	prng.FillUint64s(buf)
+	prng.FillFloat64s(make([]float64, 1), ClosedOpen)
+	prng.FillBytes(make([]byte, 1))
+	if called || buf[0] != 1 {
+		t.Error("<nil>.Fill*() changes buf or calls f.")
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
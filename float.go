@@ -0,0 +1,92 @@
+package mt
+
+import (
+	"math"
+	"math/bits"
+	"math/rand/v2"
+)
+
+// Float32 generates a random number (float32) on the interval iv.
+func (prng *PRNG) Float32(iv Interval) (f float32) {
+	if prng == nil {
+		return 0
+	}
+	prng.mutex.Lock()
+	f = iv.Float32(prng.source.Uint64())
+	prng.mutex.Unlock()
+	return
+}
+
+// Float64Full generates a random number on [0,1)-real-interval with full precision (see Float64Full function).
+func (prng *PRNG) Float64Full() (f float64) {
+	if prng == nil {
+		return 0
+	}
+	prng.mutex.Lock()
+	f = Float64Full(prng.source)
+	prng.mutex.Unlock()
+	return
+}
+
+// Float64Full generates a random number on [0,1)-real-interval with full precision, in the style of Downey's algorithm
+// ("Generating Pseudo-random Floating-Point Values", 2007).
+// Every representable float64 in [0,1) (including subnormal numbers) can be generated,
+// with the probability of a uniform real number on [0,1) rounded down to it.
+// It uses one 64-bit random number in most cases (two with probability 1/4096).
+func Float64Full(src rand.Source) float64 {
+	n := src.Uint64()
+	mant := n >> 12 // 52-bit mantissa
+	// exponent: geometric distribution by trailing zeros of remaining bits
+	exp := -1
+	word, nbits := n&0xfff, 12
+	for {
+		if word != 0 {
+			exp -= bits.TrailingZeros64(word)
+			break
+		}
+		exp -= nbits
+		if exp < -1074 {
+			return 0
+		}
+		word, nbits = src.Uint64(), 64
+	}
+	if exp < -1022 { // subnormal number
+		if exp < -1074 {
+			return 0
+		}
+		return math.Float64frombits((1<<52 | mant) >> (-1022 - exp))
+	}
+	return math.Float64frombits(uint64(exp+1023)<<52 | mant)
+}
+
+// float64Of generates a random number on the interval iv from src.
+// If src has Float64(Interval) method (e.g. *dsfmt.Source with native floating-point numbers), it is used.
+func float64Of(src rand.Source, iv Interval) float64 {
+	if s, ok := src.(interface{ Float64(Interval) float64 }); ok {
+		return s.Float64(iv)
+	}
+	return iv.Float64(src.Uint64())
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
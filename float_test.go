@@ -0,0 +1,72 @@
+package mt
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat32(t *testing.T) {
+	for _, iv := range []Interval{Closed, ClosedOpen, OpenClosed, Open} {
+		prng := New(&wordSource{words: []uint64{0, math.MaxUint64}})
+		if f, res := prng.Float32(iv), iv.Float32(0); f != res {
+			t.Errorf("PRNG.Float32(%v) with all-zero word = %v, want %v.", iv, f, res)
+		}
+		if f, res := prng.Float32(iv), iv.Float32(math.MaxUint64); f != res {
+			t.Errorf("PRNG.Float32(%v) with all-one word = %v, want %v.", iv, f, res)
+		}
+	}
+	if f := (*PRNG)(nil).Float32(Closed); f != 0 {
+		t.Errorf("PRNG.Float32() = %v, want %v.", f, 0)
+	}
+}
+
+func TestFloat64Full(t *testing.T) {
+	zeros := func(n int) []uint64 { return make([]uint64, n) }
+	testCases := []struct {
+		words []uint64
+		res   float64
+	}{
+		{words: []uint64{math.MaxUint64}, res: 1 - 0x1p-53},
+		{words: []uint64{1}, res: 0.5},
+		{words: []uint64{1 << 11}, res: 0x1p-12},
+		{words: []uint64{0xabcdef<<12 | 1<<11}, res: 0x1p-12 * (1 + 0xabcdef*0x1p-52)},
+		{words: []uint64{0, 1}, res: 0x1p-13},
+		{words: []uint64{0, 0, 1 << 63}, res: 0x1p-140},
+		{words: append(zeros(16), 1<<50), res: 0x1p-1023}, // subnormal
+		{words: append(zeros(17), 1<<37), res: 0x1p-1074}, // minimum subnormal
+		{words: append(zeros(17), 1<<38), res: 0},         // underflow
+		{words: append([]uint64{1 << 12}, zeros(17)...), res: 0},
+	}
+	for _, tc := range testCases {
+		f := New(&wordSource{words: tc.words}).Float64Full()
+		if f != tc.res {
+			t.Errorf("PRNG.Float64Full() with %x = %v, want %v.", tc.words, f, tc.res)
+		}
+	}
+	if f := (*PRNG)(nil).Float64Full(); f != 0 {
+		t.Errorf("PRNG.Float64Full() = %v, want %v.", f, 0)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
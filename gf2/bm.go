@@ -0,0 +1,81 @@
+package gf2
+
+import (
+	"math/bits"
+)
+
+// BerlekampMassey returns the minimal polynomial of the first n bits of the sequence seq
+// (bit i of seq[i/64] is the i-th bit), by Berlekamp-Massey algorithm.
+// If the sequence is generated by a linear recurrence of degree d (such as the least significant bits of
+// Mersenne Twister outputs) and n >= 2d, the result is the minimal polynomial of the recurrence
+// (the characteristic polynomial, if it is irreducible).
+func BerlekampMassey(seq []uint64, n int) Poly {
+	n = min(n, len(seq)*64)
+	// rev is the reversed sequence (bit k of rev is the (n-1-k)-th bit of seq),
+	// so that the discrepancy is an inner product of c and a window of rev.
+	rev := make(Poly, n/64+2)
+	for i := 0; i < n; i++ {
+		if (seq[i/64]>>(i%64))&1 != 0 {
+			rev.Flip(n - 1 - i)
+		}
+	}
+	c, b := New(n/2+1), New(n/2+1) // connection polynomials
+	c[0], b[0] = 1, 1
+	l, m := 0, 1
+	for i := 0; i < n; i++ {
+		// d = sum_{j=0}^{l} c_j * s_{i-j} = sum_j c_j * rev_{n-1-i+j}
+		d := uint64(0)
+		off := n - 1 - i
+		w, sh := off/64, uint(off%64)
+		for k := 0; k <= l/64; k++ {
+			x := rev[w+k] >> sh
+			if sh > 0 {
+				x |= rev[w+k+1] << (64 - sh)
+			}
+			d ^= c[k] & x
+		}
+		if bits.OnesCount64(d)&1 == 0 {
+			m++
+			continue
+		}
+		if 2*l <= i {
+			t := c.Clone()
+			c.AddShift(b, m)
+			l, b, m = i+1-l, t, 1
+		} else {
+			c.AddShift(b, m)
+			m++
+		}
+	}
+	// the minimal polynomial is the reciprocal of the connection polynomial
+	p := New(l)
+	for j := 0; j <= l; j++ {
+		if c.Coeff(j) != 0 {
+			p.Flip(l - j)
+		}
+	}
+	return p
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,64 @@
+package gf2
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// lfsr returns n bits generated by the linear recurrence whose characteristic polynomial is f.
+func lfsr(f Poly, init uint64, n int) []uint64 {
+	d := f.Degree()
+	seq := make(Poly, n/64+1)
+	for i := 0; i < n; i++ {
+		var b uint64
+		if i < d {
+			b = (init >> i) & 1
+		} else {
+			for j := 0; j < d; j++ {
+				b ^= f.Coeff(j) & seq.Coeff(i-d+j)
+			}
+		}
+		if b != 0 {
+			seq.Flip(i)
+		}
+	}
+	return seq
+}
+
+func TestBerlekampMassey(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(11, 12))
+	for _, f := range []Poly{{0xb}, {0x25}, {0x1, 0x1}, Add(Monomial(127), Poly{0x3})} {
+		n := 2*f.Degree() + 10
+		if r := BerlekampMassey(lfsr(f, rnd.Uint64()|1, n), n); r.Degree() > f.Degree() {
+			t.Errorf("BerlekampMassey() = %v, want a divisor of %v.", r, f)
+		} else if _, rem := DivMod(f, r); !rem.IsZero() {
+			t.Errorf("BerlekampMassey() = %v, want a divisor of %v.", r, f)
+		}
+	}
+	if r := BerlekampMassey(make([]uint64, 4), 256); !r.Equal(Poly{1}) {
+		t.Errorf("BerlekampMassey(zeros) = %v, want 1.", r)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
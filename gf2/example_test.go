@@ -0,0 +1,55 @@
+package gf2_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/goark/mt/v2/gf2"
+	"github.com/goark/mt/v2/mt19937"
+)
+
+func ExampleBerlekampMassey() {
+	// characteristic polynomial of MT19937-64 from the least significant bits of its output
+	const n = 2 * 19937
+	seq := make([]uint64, n/64+1)
+	src := mt19937.New(19650218)
+	for i := 0; i < n; i++ {
+		seq[i/64] |= (src.Uint64() & 1) << (i % 64)
+	}
+	charPoly := gf2.BerlekampMassey(seq, n)
+	fmt.Println(charPoly.Degree())
+	//Output:
+	//19937
+}
+
+func ExampleModulus_ExpX() {
+	m := gf2.NewModulus(gf2.Poly{0x13}) // x^4 + x + 1
+	fmt.Println(m.ExpX(big.NewInt(15))) // the period of x^4 + x + 1 is 15
+	fmt.Println(m.ExpX(big.NewInt(6)))
+	//Output:
+	//1
+	//x^3 + x^2
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,266 @@
+package gf2
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// Modulus is a polynomial prepared for repeated modular reductions.
+type Modulus struct {
+	m       Poly
+	deg     int
+	shifted [64]Poly  //m * x^i (i = 0 to 63)
+	nonzero [64][]int //indexes of non-zero words of shifted[i]
+	terms   []int     //exponents of terms of m (if m is sparse)
+	chunk   int       //number of bits reduced at once with terms (deg - degree of (m - x^deg))
+}
+
+// NewModulus returns Modulus of polynomial m. m must not be zero polynomial (otherwise it panics).
+func NewModulus(m Poly) *Modulus {
+	deg := m.Degree()
+	if deg < 0 {
+		panic("gf2: zero modulus")
+	}
+	md := &Modulus{m: m.Clone().Trim(), deg: deg}
+	for i := range md.shifted {
+		sh := make(Poly, len(md.m)+1)
+		sh.AddShift(md.m, i)
+		md.shifted[i] = sh
+		for j, w := range sh {
+			if w != 0 {
+				md.nonzero[i] = append(md.nonzero[i], j)
+			}
+		}
+	}
+	// sparse modulus (such as characteristic polynomials of MT) is reduced by terms, chunk bits at once
+	rest := md.m.Clone()
+	rest.Flip(deg)
+	md.chunk = deg - rest.Degree()
+	for i := 0; i <= deg; i++ {
+		if md.m.Coeff(i) != 0 {
+			md.terms = append(md.terms, i)
+		}
+	}
+	if len(md.terms)*64 > 16*len(md.nonzero[0])*min(md.chunk, 64) {
+		md.terms = nil // dense modulus
+	}
+	return md
+}
+
+// Poly returns the modulus polynomial.
+func (md *Modulus) Poly() Poly {
+	return md.m.Clone()
+}
+
+// Degree returns the degree of the modulus polynomial.
+func (md *Modulus) Degree() int {
+	return md.deg
+}
+
+// Reduce returns p mod m.
+func (md *Modulus) Reduce(p Poly) Poly {
+	r := make(Poly, len(p)+1)
+	copy(r, p)
+	md.reduce(r)
+	res := New(md.deg - 1)
+	copy(res, r)
+	return res
+}
+
+// reduce sets p = p mod m (in place).
+func (md *Modulus) reduce(p Poly) {
+	if md.terms != nil {
+		md.reduceSparse(p)
+		return
+	}
+	for k := len(p) - 1; k >= 0 && k*64+63 >= md.deg; k-- {
+		for p[k] != 0 {
+			d := k*64 + 63 - bits.LeadingZeros64(p[k])
+			if d < md.deg {
+				break
+			}
+			n := d - md.deg
+			sh, q := md.shifted[n%64], p[n/64:]
+			for _, i := range md.nonzero[n%64] {
+				q[i] ^= sh[i]
+			}
+		}
+	}
+}
+
+// reduceSparse sets p = p mod m (in place) by terms of m.
+// Bits of p in [s, s+chunk) are reduced at once, since x^s * v(x) = x^(s-deg) * v(x) * (m - x^deg) mod m
+// and the degree of the right side is less than s. p must have a zero word at the top.
+func (md *Modulus) reduceSparse(p Poly) {
+	k := len(p) - 1
+	block := make(Poly, (md.chunk+63)/64+1)
+	for {
+		for k >= 0 && p[k] == 0 {
+			k--
+		}
+		if k < 0 {
+			return
+		}
+		d := k*64 + 63 - bits.LeadingZeros64(p[k])
+		if d < md.deg {
+			return
+		}
+		s := max(d-md.chunk+1, md.deg)
+		n := (d - s + 64) / 64
+		for i := 0; i < n; i++ {
+			block[i] = extract(p, s+64*i, min(64, d-s+1-64*i))
+		}
+		block[n] = 0
+		// terms include x^deg, which clears the bits of the block
+		base := s - md.deg
+		for _, e := range md.terms {
+			pos := base + e
+			q, b := p[pos/64:], uint(pos%64)
+			if b == 0 {
+				for i, v := range block[:n] {
+					q[i] ^= v
+				}
+				continue
+			}
+			q = q[:n+1]
+			q[0] ^= block[0] << b
+			for i := 1; i <= n; i++ {
+				q[i] ^= block[i]<<b | block[i-1]>>(64-b)
+			}
+		}
+	}
+}
+
+// extract returns n bits (n <= 64) of p from position s.
+func extract(p Poly, s, n int) uint64 {
+	w, b := s/64, uint(s%64)
+	v := p[w] >> b
+	if b > 0 && w+1 < len(p) {
+		v |= p[w+1] << (64 - b)
+	}
+	if n < 64 {
+		v &= 1<<n - 1
+	}
+	return v
+}
+
+// Mul returns p * q mod m.
+func (md *Modulus) Mul(p, q Poly) Poly {
+	return md.Reduce(Mul(p, q))
+}
+
+// Sqr returns p^2 mod m.
+func (md *Modulus) Sqr(p Poly) Poly {
+	return md.Reduce(Sqr(p))
+}
+
+// Exp returns p^n mod m (n >= 0).
+func (md *Modulus) Exp(p Poly, n *big.Int) Poly {
+	r := md.Reduce(Poly{1})
+	b := md.Reduce(p)
+	for i := n.BitLen() - 1; i >= 0; i-- {
+		r = md.Sqr(r)
+		if n.Bit(i) != 0 {
+			r = md.Mul(r, b)
+		}
+	}
+	return r
+}
+
+// ExpX returns x^n mod m (n >= 0).
+func (md *Modulus) ExpX(n *big.Int) Poly {
+	r := md.Reduce(Poly{1})
+	for i := n.BitLen() - 1; i >= 0; i-- {
+		r = md.Sqr(r)
+		if n.Bit(i) != 0 {
+			r = md.mulX(r)
+		}
+	}
+	return r
+}
+
+// ExpX2 returns x^(2^e) mod m (e >= 0).
+func (md *Modulus) ExpX2(e int) Poly {
+	r := md.Reduce(Poly{2})
+	for i := 0; i < e; i++ {
+		r = md.Sqr(r)
+	}
+	return r
+}
+
+// mulX returns p * x mod m (p is reduced).
+func (md *Modulus) mulX(p Poly) Poly {
+	r := make(Poly, len(p)+2)
+	r.AddShift(p, 1)
+	md.reduce(r)
+	return r[:len(p)]
+}
+
+// Mod returns p mod m. m must not be zero polynomial (otherwise it panics).
+func Mod(p, m Poly) Poly {
+	return NewModulus(m).Reduce(p)
+}
+
+// DivMod returns quotient and remainder of p / m. m must not be zero polynomial (otherwise it panics).
+func DivMod(p, m Poly) (Poly, Poly) {
+	dm := m.Degree()
+	if dm < 0 {
+		panic("gf2: division by zero polynomial")
+	}
+	r := p.Clone()
+	dp := r.Degree()
+	if dp < dm {
+		return Poly{}, r.Trim()
+	}
+	q := New(dp - dm)
+	for d := dp; d >= dm; d-- {
+		if r.Coeff(d) != 0 {
+			r.AddShift(m, d-dm)
+			q.Flip(d - dm)
+		}
+	}
+	return q, r.Trim()
+}
+
+// MulMod returns p * q mod m.
+func MulMod(p, q, m Poly) Poly {
+	return Mod(Mul(p, q), m)
+}
+
+// ExpXMod returns x^n mod m (n >= 0).
+func ExpXMod(n *big.Int, m Poly) Poly {
+	return NewModulus(m).ExpX(n)
+}
+
+// GCD returns the greatest common divisor of p and q.
+func GCD(p, q Poly) Poly {
+	a, b := p.Clone(), q.Clone()
+	for !b.IsZero() {
+		_, r := DivMod(a, b)
+		a, b = b, r
+	}
+	return a.Trim()
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,135 @@
+package gf2
+
+import (
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestDivMod(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(5, 6))
+	for _, sz := range [][2]int{{1, 1}, {5, 2}, {40, 10}, {100, 99}, {2, 5}} {
+		p, m := randomPoly(rnd, sz[0]), randomPoly(rnd, sz[1])
+		q, r := DivMod(p, m)
+		if r.Degree() >= m.Degree() {
+			t.Errorf("degree of remainder = %v, want less than %v.", r.Degree(), m.Degree())
+		}
+		if res := Add(Mul(q, m), r); !res.Equal(p) {
+			t.Errorf("q*m + r is not equal to p (%v words / %v words).", sz[0], sz[1])
+		}
+		if res := Mod(p, m); !res.Equal(r) {
+			t.Errorf("Mod() = %v, want %v.", res, r)
+		}
+	}
+	p := Poly{0xb} // x^3 + x + 1
+	if r := Mod(Monomial(7), p); !r.Equal(Poly{1}) {
+		t.Errorf("x^7 mod (%v) = %v, want 1.", p, r)
+	}
+}
+
+func TestModulus(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(7, 8))
+	sparse := Monomial(1279)
+	sparse.AddShift(Poly{0x1}, 0)
+	sparse.AddShift(Poly{0x1}, 418) // x^1279 + x^418 + 1
+	for _, m := range []Poly{randomPoly(rnd, 20), sparse, Poly{0x3}} {
+		md := NewModulus(m)
+		if md.Degree() != m.Degree() || !md.Poly().Equal(m) {
+			t.Errorf("Modulus.Poly() = %v, want %v.", md.Poly(), m)
+		}
+		p, q := randomPoly(rnd, 30), randomPoly(rnd, 25)
+		if r, res := md.Reduce(p), Mod(p, m); !r.Equal(res) {
+			t.Errorf("Modulus.Reduce() = %v, want %v.", r, res)
+		}
+		if _, res := DivMod(p, m); !md.Reduce(p).Equal(res) {
+			t.Errorf("Modulus.Reduce() = %v, want %v.", md.Reduce(p), res)
+		}
+		p, q = md.Reduce(p), md.Reduce(q)
+		if r, res := md.Mul(p, q), MulMod(p, q, m); !r.Equal(res) {
+			t.Errorf("Modulus.Mul() = %v, want %v.", r, res)
+		}
+		if r, res := md.Sqr(p), MulMod(p, p, m); !r.Equal(res) {
+			t.Errorf("Modulus.Sqr() = %v, want %v.", r, res)
+		}
+		// x^n by repeated multiplication
+		x := md.Reduce(Poly{1})
+		for n := 0; n < 3000; n++ {
+			if n%257 == 0 || n == 2048 {
+				if r := md.ExpX(big.NewInt(int64(n))); !r.Equal(x) {
+					t.Errorf("Modulus.ExpX(%v) = %v, want %v.", n, r, x)
+				}
+				if r := ExpXMod(big.NewInt(int64(n)), m); !r.Equal(x) {
+					t.Errorf("ExpXMod(%v) = %v, want %v.", n, r, x)
+				}
+			}
+			x = MulMod(x, Poly{2}, m)
+		}
+		if r, res := md.ExpX2(11), md.ExpX(big.NewInt(2048)); !r.Equal(res) {
+			t.Errorf("Modulus.ExpX2(11) = %v, want %v.", r, res)
+		}
+		// p^n by repeated multiplication
+		y := md.Reduce(Poly{1})
+		for n := 0; n < 100; n++ {
+			if r := md.Exp(p, big.NewInt(int64(n))); !r.Equal(y) {
+				t.Errorf("Modulus.Exp(p, %v) = %v, want %v.", n, r, y)
+			}
+			y = md.Mul(y, p)
+		}
+		// x^(2^128) by big exponent
+		if r, res := md.ExpX(new(big.Int).Lsh(big.NewInt(1), 128)), md.ExpX2(128); !r.Equal(res) {
+			t.Errorf("Modulus.ExpX(2^128) = %v, want %v.", r, res)
+		}
+	}
+	// x^1279 + x^418 + 1 is primitive (Mersenne exponent 1279)
+	if r := NewModulus(sparse).ExpX2(1279); !r.Equal(Poly{2}) {
+		t.Errorf("x^(2^1279) mod (%v) = %v, want x.", sparse, r)
+	}
+}
+
+func TestGCD(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(9, 10))
+	g := Poly{0x25} // x^5 + x^2 + 1 (irreducible)
+	p, q := Mul(g, randomPoly(rnd, 3)), Mul(g, randomPoly(rnd, 4))
+	r := GCD(p, q)
+	if _, rem := DivMod(r, g); !rem.IsZero() {
+		t.Errorf("GCD() = %v, want a multiple of %v.", r, g)
+	}
+	if _, rem := DivMod(p, r); !rem.IsZero() {
+		t.Errorf("GCD() = %v does not divide %v.", r, p)
+	}
+	if r := GCD(Poly{0xb}, Poly{0x25}); !r.Equal(Poly{1}) {
+		t.Errorf("GCD() of irreducible polynomials = %v, want 1.", r)
+	}
+}
+
+func TestZeroModulus(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewModulus(0) does not panic.")
+		}
+	}()
+	_ = NewModulus(Poly{0})
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,80 @@
+package gf2_test
+
+import (
+	"testing"
+
+	"github.com/goark/mt/v2/gf2"
+	"github.com/goark/mt/v2/mt19937"
+	"github.com/goark/mt/v2/mt19937ar"
+)
+
+// These tests are in the external package gf2_test
+// because mt19937 package depends on gf2 package.
+
+// outputBits returns the least significant bits of n outputs.
+func outputBits(f func() uint64, n int) []uint64 {
+	seq := make(gf2.Poly, n/64+1)
+	for i := 0; i < n; i++ {
+		if f()&1 != 0 {
+			seq.Flip(i)
+		}
+	}
+	return seq
+}
+
+// TestMT19937 recomputes the characteristic polynomial of MT19937 from its own output,
+// and checks that the polynomial annihilates other output sequences and that it is primitive (period 2^19937-1).
+func TestMT19937(t *testing.T) {
+	const mexp = 19937
+	for _, tc := range []struct {
+		name string
+		f    func(seed int64) func() uint64
+	}{
+		{name: "mt19937", f: func(seed int64) func() uint64 { return mt19937.New(seed).Uint64 }},
+		{name: "mt19937ar", f: func(seed int64) func() uint64 {
+			s := mt19937ar.New(seed)
+			return func() uint64 { return uint64(s.Uint32()) }
+		}},
+	} {
+		f := gf2.BerlekampMassey(outputBits(tc.f(5489), 2*mexp), 2*mexp)
+		if d := f.Degree(); d != mexp {
+			t.Errorf("degree of characteristic polynomial of %v = %v, want %v.", tc.name, d, mexp)
+			continue
+		}
+		// f annihilates output of another seed
+		seq := outputBits(tc.f(19650218), 2*mexp)
+		if g := gf2.BerlekampMassey(seq, 2*mexp); !g.Equal(f) {
+			t.Errorf("characteristic polynomial of %v depends on the seed.", tc.name)
+		}
+		if tc.name != "mt19937" || testing.Short() {
+			continue
+		}
+		// x^(2^19937) == x mod f (f is irreducible, and primitive since 2^19937-1 is prime)
+		if r := gf2.NewModulus(f).ExpX2(mexp); !r.Equal(gf2.Poly{2}) {
+			t.Errorf("characteristic polynomial of %v is not primitive.", tc.name)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
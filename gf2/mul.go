@@ -0,0 +1,151 @@
+package gf2
+
+// karatsubaThreshold is the number of words below which schoolbook multiplication is used.
+const karatsubaThreshold = 16
+
+// Mul returns p * q (by Karatsuba multiplication).
+func Mul(p, q Poly) Poly {
+	p, q = p.Trim(), q.Trim()
+	if len(p) == 0 || len(q) == 0 {
+		return Poly{}
+	}
+	r := make(Poly, len(p)+len(q))
+	mulWords(r, p, q)
+	return r
+}
+
+// Sqr returns p^2. Squaring over GF(2) only spreads bits of p.
+func Sqr(p Poly) Poly {
+	p = p.Trim()
+	r := make(Poly, 2*len(p))
+	for i, w := range p {
+		r[2*i] = spread(uint32(w))
+		r[2*i+1] = spread(uint32(w >> 32))
+	}
+	return r
+}
+
+// spread returns v with a zero bit inserted after each bit.
+func spread(v uint32) uint64 {
+	w := uint64(v)
+	w = (w | w<<16) & 0x0000ffff0000ffff
+	w = (w | w<<8) & 0x00ff00ff00ff00ff
+	w = (w | w<<4) & 0x0f0f0f0f0f0f0f0f
+	w = (w | w<<2) & 0x3333333333333333
+	w = (w | w<<1) & 0x5555555555555555
+	return w
+}
+
+// mulWords adds a * b to r (len(r) >= len(a)+len(b)).
+func mulWords(r, a, b []uint64) {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	if len(b) == 0 {
+		return
+	}
+	if len(b) < karatsubaThreshold {
+		for i, x := range b {
+			if x == 0 {
+				continue
+			}
+			for j, y := range a {
+				hi, lo := clmul(y, x)
+				r[i+j] ^= lo
+				r[i+j+1] ^= hi
+			}
+		}
+		return
+	}
+	if len(a) >= 2*len(b) {
+		// unbalanced: multiply by chunks of a
+		for i := 0; i < len(a); i += len(b) {
+			mulWords(r[i:], a[i:min(i+len(b), len(a))], b)
+		}
+		return
+	}
+
+	// a = a0 + a1*X, b = b0 + b1*X (X = x^(64*h))
+	// a*b = z0 + ((a0+a1)(b0+b1) - z0 - z2)*X + z2*X^2
+	h := (len(a) + 1) / 2
+	a0, a1 := a[:h], a[h:]
+	b0, b1 := b[:min(h, len(b))], b[min(h, len(b)):]
+	z0 := make([]uint64, len(a0)+len(b0))
+	mulWords(z0, a0, b0)
+	z2 := make([]uint64, len(a1)+len(b1))
+	mulWords(z2, a1, b1)
+	as := append([]uint64{}, a0...)
+	for i, w := range a1 {
+		as[i] ^= w
+	}
+	bs := append([]uint64{}, b0...)
+	for i, w := range b1 {
+		bs[i] ^= w
+	}
+	z1 := make([]uint64, len(as)+len(bs))
+	mulWords(z1, as, bs)
+	for i, w := range z0 {
+		r[i] ^= w
+		z1[i] ^= w
+	}
+	for i, w := range z2 {
+		r[i+2*h] ^= w
+		z1[i] ^= w
+	}
+	for i, w := range z1 {
+		if w != 0 {
+			r[i+h] ^= w
+		}
+	}
+}
+
+// clmul returns carry-less product of a and b (128 bits).
+func clmul(a, b uint64) (hi, lo uint64) {
+	// table of multiples of a (without the most significant 3 bits) by 4-bit values
+	const top = 0xe000000000000000
+	a0 := a &^ top
+	var tab [16]uint64
+	for i := 1; i < 16; i++ {
+		if i&1 == 0 {
+			tab[i] = tab[i>>1] << 1
+		} else {
+			tab[i] = tab[i-1] ^ a0
+		}
+	}
+	lo = tab[b>>60]
+	for s := 56; s >= 0; s -= 4 {
+		hi = hi<<4 | lo>>60
+		lo = lo<<4 ^ tab[(b>>s)&15]
+	}
+	// the most significant 3 bits of a
+	for j := 61; j < 64; j++ {
+		if (a>>j)&1 != 0 {
+			lo ^= b << j
+			hi ^= b >> (64 - j)
+		}
+	}
+	return
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
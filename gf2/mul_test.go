@@ -0,0 +1,80 @@
+package gf2
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// randomPoly returns random polynomial of degree less than 64*n.
+func randomPoly(rnd *rand.Rand, n int) Poly {
+	p := make(Poly, n)
+	for i := range p {
+		p[i] = rnd.Uint64()
+	}
+	return p
+}
+
+// mulNaive returns p * q bit by bit.
+func mulNaive(p, q Poly) Poly {
+	r := make(Poly, len(p)+len(q))
+	for i := 0; i < len(q)*64; i++ {
+		if q.Coeff(i) != 0 {
+			r.AddShift(p, i)
+		}
+	}
+	return r
+}
+
+func TestClmul(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < 1000; i++ {
+		a, b := rnd.Uint64(), rnd.Uint64()
+		if i == 0 {
+			a, b = ^uint64(0), ^uint64(0)
+		}
+		hi, lo := clmul(a, b)
+		if res := mulNaive(Poly{a}, Poly{b}); lo != res[0] || hi != res[1] {
+			t.Errorf("clmul(%#x, %#x) = (%#x, %#x), want (%#x, %#x).", a, b, hi, lo, res[1], res[0])
+		}
+	}
+}
+
+func TestMul(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(3, 4))
+	sizes := [][2]int{{1, 1}, {3, 5}, {15, 16}, {16, 16}, {17, 40}, {40, 17}, {100, 3}, {100, 99}, {313, 313}}
+	for _, sz := range sizes {
+		p, q := randomPoly(rnd, sz[0]), randomPoly(rnd, sz[1])
+		if r, res := Mul(p, q), mulNaive(p, q); !r.Equal(res) {
+			t.Errorf("Mul() with %v words is not equal to naive multiplication.", sz)
+		}
+		if r, res := Sqr(p), mulNaive(p, p); !r.Equal(res) {
+			t.Errorf("Sqr() with %v words is not equal to naive multiplication.", sz[0])
+		}
+	}
+	if r := Mul(Poly{0xb}, nil); !r.IsZero() {
+		t.Errorf("Mul(p, 0) = %v, want 0.", r)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
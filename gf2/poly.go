@@ -0,0 +1,153 @@
+// Package gf2 provides arithmetic of polynomials over GF(2) (the field of two elements).
+// It is used for characteristic polynomials of Mersenne Twister (degree 19937 and so on),
+// jump-ahead polynomials and verification of parameter sets.
+package gf2
+
+import (
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// Poly is a polynomial over GF(2).
+// Bit i of Poly[i/64] is the coefficient of x^i (words are in little endian order).
+// Zero value (or slice of zero words) is zero polynomial.
+type Poly []uint64
+
+// New returns zero polynomial with room for degree deg.
+func New(deg int) Poly {
+	return make(Poly, max(deg, 0)/64+1)
+}
+
+// Monomial returns x^n.
+func Monomial(n int) Poly {
+	p := New(n)
+	p.Flip(n)
+	return p
+}
+
+// Degree returns the degree of p. The degree of zero polynomial is -1.
+func (p Poly) Degree() int {
+	for i := len(p) - 1; i >= 0; i-- {
+		if p[i] != 0 {
+			return i*64 + 63 - bits.LeadingZeros64(p[i])
+		}
+	}
+	return -1
+}
+
+// IsZero returns true if p is zero polynomial.
+func (p Poly) IsZero() bool {
+	return p.Degree() < 0
+}
+
+// Coeff returns the coefficient (0 or 1) of x^i.
+func (p Poly) Coeff(i int) uint64 {
+	if i < 0 || i/64 >= len(p) {
+		return 0
+	}
+	return (p[i/64] >> (i % 64)) & 1
+}
+
+// Flip flips the coefficient of x^i. i must be less than len(p)*64.
+func (p Poly) Flip(i int) {
+	p[i/64] ^= 1 << (i % 64)
+}
+
+// Clone returns a copy of p.
+func (p Poly) Clone() Poly {
+	return append(Poly{}, p...)
+}
+
+// Trim returns p without zero words of higher degree (sharing the underlying array).
+func (p Poly) Trim() Poly {
+	return p[:(p.Degree()+64)/64]
+}
+
+// Equal returns true if p and q are the same polynomial (ignoring zero words of higher degree).
+func (p Poly) Equal(q Poly) bool {
+	p, q = p.Trim(), q.Trim()
+	if len(p) != len(q) {
+		return false
+	}
+	for i := range p {
+		if p[i] != q[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns p in the form of "x^3 + x + 1".
+func (p Poly) String() string {
+	d := p.Degree()
+	if d < 0 {
+		return "0"
+	}
+	terms := []string{}
+	for i := d; i >= 0; i-- {
+		if p.Coeff(i) == 0 {
+			continue
+		}
+		switch i {
+		case 0:
+			terms = append(terms, "1")
+		case 1:
+			terms = append(terms, "x")
+		default:
+			terms = append(terms, "x^"+strconv.Itoa(i))
+		}
+	}
+	return strings.Join(terms, " + ")
+}
+
+// Add returns p + q (same as p - q).
+func Add(p, q Poly) Poly {
+	if len(p) < len(q) {
+		p, q = q, p
+	}
+	r := p.Clone()
+	for i, w := range q {
+		r[i] ^= w
+	}
+	return r
+}
+
+// AddShift sets p = p + q * x^n. Terms of degree len(p)*64 or more are discarded.
+func (p Poly) AddShift(q Poly, n int) {
+	w, b := n/64, uint(n%64)
+	for i := len(q) - 1; i >= 0; i-- {
+		if q[i] == 0 {
+			continue
+		}
+		if i+w < len(p) {
+			p[i+w] ^= q[i] << b
+		}
+		if b > 0 && i+w+1 < len(p) {
+			p[i+w+1] ^= q[i] >> (64 - b)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,96 @@
+package gf2
+
+import (
+	"testing"
+)
+
+func TestPoly(t *testing.T) {
+	testCases := []struct {
+		p      Poly
+		deg    int
+		str    string
+		isZero bool
+	}{
+		{p: nil, deg: -1, str: "0", isZero: true},
+		{p: Poly{0, 0}, deg: -1, str: "0", isZero: true},
+		{p: Poly{1}, deg: 0, str: "1", isZero: false},
+		{p: Poly{0xb}, deg: 3, str: "x^3 + x + 1", isZero: false},
+		{p: Poly{2, 1, 0}, deg: 64, str: "x^64 + x", isZero: false},
+		{p: Monomial(130), deg: 130, str: "x^130", isZero: false},
+	}
+	for _, tc := range testCases {
+		if d := tc.p.Degree(); d != tc.deg {
+			t.Errorf("Poly(%v).Degree() = %v, want %v.", tc.p, d, tc.deg)
+		}
+		if s := tc.p.String(); s != tc.str {
+			t.Errorf("Poly(%v).String() = %v, want %v.", []uint64(tc.p), s, tc.str)
+		}
+		if b := tc.p.IsZero(); b != tc.isZero {
+			t.Errorf("Poly(%v).IsZero() = %v, want %v.", tc.p, b, tc.isZero)
+		}
+		if len(tc.p.Trim()) != (tc.deg+64)/64 {
+			t.Errorf("len(Poly(%v).Trim()) = %v, want %v.", tc.p, len(tc.p.Trim()), (tc.deg+64)/64)
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	testCases := []struct {
+		p, q Poly
+		res  bool
+	}{
+		{p: nil, q: Poly{0}, res: true},
+		{p: Poly{3}, q: Poly{3, 0, 0}, res: true},
+		{p: Poly{3}, q: Poly{3, 1}, res: false},
+		{p: Poly{3}, q: Poly{2}, res: false},
+	}
+	for _, tc := range testCases {
+		if b := tc.p.Equal(tc.q); b != tc.res {
+			t.Errorf("Poly(%v).Equal(%v) = %v, want %v.", tc.p, tc.q, b, tc.res)
+		}
+	}
+}
+
+func TestAdd(t *testing.T) {
+	p, q := Poly{0xb, 1}, Poly{0x3}
+	if r, res := Add(p, q), (Poly{0x8, 1}); !r.Equal(res) {
+		t.Errorf("Add(%v, %v) = %v, want %v.", p, q, r, res)
+	}
+	if !p.Equal(Poly{0xb, 1}) {
+		t.Errorf("Add() changes the argument: %v.", p)
+	}
+	r := New(130)
+	r.AddShift(Poly{0xb}, 126)
+	if res := (Poly{0, 3 << 62, 0x2}); !r.Equal(res) {
+		t.Errorf("Poly.AddShift() = %v, want %v.", r, res)
+	}
+	if c := r.Coeff(126); c != 1 {
+		t.Errorf("Poly.Coeff(126) = %v, want 1.", c)
+	}
+	if c := r.Coeff(1000); c != 0 {
+		t.Errorf("Poly.Coeff(1000) = %v, want 0.", c)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
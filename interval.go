@@ -0,0 +1,91 @@
+package mt
+
+// Interval is a type of real interval between 0 and 1.
+type Interval int
+
+const (
+	Open       Interval = iota // (0,1)-real-interval
+	Closed                     // [0,1]-real-interval
+	ClosedOpen                 // [0,1)-real-interval
+	OpenClosed                 // (0,1]-real-interval
+)
+
+// ModeInterval returns Interval corresponding to mode of Real method (deprecated int modes):
+// 1 is Closed ([0,1]), 2 is ClosedOpen ([0,1)), and others are Open ((0,1)).
+func ModeInterval(mode int) Interval {
+	switch mode {
+	case 1:
+		return Closed
+	case 2:
+		return ClosedOpen
+	default:
+		return Open
+	}
+}
+
+// String returns the notation of Interval (Stringer interface).
+func (iv Interval) String() string {
+	switch iv {
+	case Closed:
+		return "[0,1]"
+	case ClosedOpen:
+		return "[0,1)"
+	case OpenClosed:
+		return "(0,1]"
+	default:
+		return "(0,1)"
+	}
+}
+
+// Float64 converts a 64-bit random number n to a floating-point number on the interval.
+// Closed, ClosedOpen and OpenClosed have 53-bit resolution, and Open has 52-bit resolution.
+func (iv Interval) Float64(n uint64) float64 {
+	switch iv {
+	case Closed:
+		return float64(n>>11) * (1.0 / 9007199254740991.0)
+	case ClosedOpen:
+		return float64(n>>11) * (1.0 / 9007199254740992.0)
+	case OpenClosed:
+		return (float64(n>>11) + 1.0) * (1.0 / 9007199254740992.0)
+	default:
+		return (float64(n>>12) + 0.5) * (1.0 / 4503599627370496.0)
+	}
+}
+
+// Float32 converts a 64-bit random number n to a floating-point number (float32) on the interval.
+// Closed, ClosedOpen and OpenClosed have 24-bit resolution, and Open has 23-bit resolution.
+func (iv Interval) Float32(n uint64) float32 {
+	switch iv {
+	case Closed:
+		return float32(float64(n>>40) * (1.0 / 16777215.0))
+	case ClosedOpen:
+		return float32(n>>40) * (1.0 / 16777216.0)
+	case OpenClosed:
+		return (float32(n>>40) + 1.0) * (1.0 / 16777216.0)
+	default:
+		return (float32(n>>41) + 0.5) * (1.0 / 8388608.0)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,107 @@
+package mt
+
+import (
+	"math"
+	"testing"
+)
+
+// mockup for test (generates words in order)
+type wordSource struct {
+	words []uint64
+}
+
+func (s *wordSource) SeedArray(seeds []uint64) {}
+func (s *wordSource) Real(mode int) float64    { return ModeInterval(mode).Float64(s.Uint64()) }
+func (s *wordSource) Uint64() uint64 {
+	w := s.words[0]
+	s.words = s.words[1:]
+	return w
+}
+
+func TestInterval(t *testing.T) {
+	testCases := []struct {
+		iv       Interval
+		str      string
+		min, max float64
+	}{
+		{iv: Closed, str: "[0,1]", min: 0, max: 1},
+		{iv: ClosedOpen, str: "[0,1)", min: 0, max: 1 - 0x1p-53},
+		{iv: OpenClosed, str: "(0,1]", min: 0x1p-53, max: 1},
+		{iv: Open, str: "(0,1)", min: 0x1p-53, max: 1 - 0x1p-53},
+	}
+	for _, tc := range testCases {
+		if s := tc.iv.String(); s != tc.str {
+			t.Errorf("Interval.String() = %v, want %v.", s, tc.str)
+		}
+		if f := tc.iv.Float64(0); f != tc.min {
+			t.Errorf("%v.Float64(0) = %v, want %v.", tc.iv, f, tc.min)
+		}
+		if f := tc.iv.Float64(math.MaxUint64); f != tc.max {
+			t.Errorf("%v.Float64(MaxUint64) = %v, want %v.", tc.iv, f, tc.max)
+		}
+		min32, max32 := float32(tc.min), float32(tc.max)
+		if tc.min > 0 {
+			min32 = 0x1p-24
+		}
+		if tc.max < 1 {
+			max32 = 1 - 0x1p-24
+		}
+		if f := tc.iv.Float32(0); f != min32 {
+			t.Errorf("%v.Float32(0) = %v, want %v.", tc.iv, f, min32)
+		}
+		if f := tc.iv.Float32(math.MaxUint64); f != max32 {
+			t.Errorf("%v.Float32(MaxUint64) = %v, want %v.", tc.iv, f, max32)
+		}
+		prng := New(&wordSource{words: []uint64{0, math.MaxUint64}})
+		if f := prng.Float64(tc.iv); f != tc.min {
+			t.Errorf("PRNG.Float64(%v) with all-zero word = %v, want %v.", tc.iv, f, tc.min)
+		}
+		if f := prng.Float64(tc.iv); f != tc.max {
+			t.Errorf("PRNG.Float64(%v) with all-one word = %v, want %v.", tc.iv, f, tc.max)
+		}
+	}
+	if f := (*PRNG)(nil).Float64(Closed); f != 0 {
+		t.Errorf("PRNG.Float64() = %v, want %v.", f, 0)
+	}
+}
+
+func TestModeInterval(t *testing.T) {
+	testCases := []struct {
+		mode int
+		iv   Interval
+	}{
+		{mode: 0, iv: Open},
+		{mode: 1, iv: Closed},
+		{mode: 2, iv: ClosedOpen},
+		{mode: 3, iv: Open},
+		{mode: -1, iv: Open},
+	}
+	for _, tc := range testCases {
+		if iv := ModeInterval(tc.mode); iv != tc.iv {
+			t.Errorf("ModeInterval(%v) = %v, want %v.", tc.mode, iv, tc.iv)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,168 @@
+package mt
+
+import (
+	"math/bits"
+	"math/rand/v2"
+)
+
+// Integer is a constraint of integer types.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Uint64N generates a random number on [0, n-1]-interval (n > 0) without bias.
+// It panics if n == 0.
+func (prng *PRNG) Uint64N(n uint64) (v uint64) {
+	if n == 0 {
+		panic("invalid argument to Uint64N")
+	}
+	if prng == nil {
+		return 0
+	}
+	prng.mutex.Lock()
+	v = uint64n(prng.source, n)
+	prng.mutex.Unlock()
+	return
+}
+
+// Uint32N generates a random number on [0, n-1]-interval (n > 0) without bias.
+// It panics if n == 0.
+func (prng *PRNG) Uint32N(n uint32) (v uint32) {
+	if n == 0 {
+		panic("invalid argument to Uint32N")
+	}
+	if prng == nil {
+		return 0
+	}
+	prng.mutex.Lock()
+	v = uint32n(prng.source, n)
+	prng.mutex.Unlock()
+	return
+}
+
+// Int64N generates a random number on [0, n-1]-interval (n > 0) without bias.
+// It panics if n <= 0.
+func (prng *PRNG) Int64N(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int64N")
+	}
+	return int64(prng.Uint64N(uint64(n)))
+}
+
+// IntN generates a random number on [0, n-1]-interval (n > 0) without bias.
+// It panics if n <= 0.
+func (prng *PRNG) IntN(n int) int {
+	if n <= 0 {
+		panic("invalid argument to IntN")
+	}
+	return int(prng.Uint64N(uint64(n)))
+}
+
+// IntRange generates a random number on [lo, hi]-interval (lo <= hi) without bias.
+// It panics if lo > hi.
+func (prng *PRNG) IntRange(lo, hi int) (v int) {
+	if lo > hi {
+		panic("invalid argument to IntRange")
+	}
+	if prng == nil {
+		return lo
+	}
+	prng.mutex.Lock()
+	v = rangeN(prng.source, lo, hi)
+	prng.mutex.Unlock()
+	return
+}
+
+// N generates a random number on [0, n-1]-interval (n > 0) without bias from src.
+// If src has Uint64N method (e.g. *PRNG), it is used.
+// It panics if n <= 0.
+func N[T Integer](src rand.Source, n T) T {
+	if n <= 0 {
+		panic("invalid argument to N")
+	}
+	if s, ok := src.(interface{ Uint64N(uint64) uint64 }); ok {
+		return T(s.Uint64N(uint64(n)))
+	}
+	return T(uint64n(src, uint64(n)))
+}
+
+// Range generates a random number on [lo, hi]-interval (lo <= hi) without bias from src.
+// If src is *PRNG, the lock is held only once.
+// It panics if lo > hi.
+func Range[T Integer](src rand.Source, lo, hi T) T {
+	if lo > hi {
+		panic("invalid argument to Range")
+	}
+	if prng, ok := src.(*PRNG); ok {
+		if prng == nil {
+			return lo
+		}
+		prng.mutex.Lock()
+		defer prng.mutex.Unlock()
+		return rangeN(prng.source, lo, hi)
+	}
+	return rangeN(src, lo, hi)
+}
+
+// uint64n generates a random number on [0, n-1]-interval by Lemire's nearly divisionless method
+// (D. Lemire, "Fast Random Integer Generation in an Interval", ACM TOMACS 29 (2019)).
+func uint64n(src rand.Source, n uint64) uint64 {
+	if n&(n-1) == 0 { // n is power of two
+		return src.Uint64() & (n - 1)
+	}
+	hi, lo := bits.Mul64(src.Uint64(), n)
+	if lo < n {
+		thresh := -n % n
+		for lo < thresh {
+			hi, lo = bits.Mul64(src.Uint64(), n)
+		}
+	}
+	return hi
+}
+
+// uint32n generates a random number on [0, n-1]-interval by Lemire's nearly divisionless method.
+func uint32n(src rand.Source, n uint32) uint32 {
+	if n&(n-1) == 0 { // n is power of two
+		return uint32(src.Uint64()>>32) & (n - 1)
+	}
+	hi, lo := bits.Mul32(uint32(src.Uint64()>>32), n)
+	if lo < n {
+		thresh := -n % n
+		for lo < thresh {
+			hi, lo = bits.Mul32(uint32(src.Uint64()>>32), n)
+		}
+	}
+	return hi
+}
+
+// rangeN generates a random number on [lo, hi]-interval.
+func rangeN[T Integer](src rand.Source, lo, hi T) T {
+	span := uint64(hi) - uint64(lo)
+	if span == ^uint64(0) {
+		return T(src.Uint64())
+	}
+	return lo + T(uint64n(src, span+1))
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,217 @@
+package mt_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"sync"
+	"testing"
+
+	"github.com/goark/mt/v2"
+	"github.com/goark/mt/v2/mt19937"
+)
+
+const is32bit = ^uint(0)>>32 == 0
+
+func TestUint64N(t *testing.T) {
+	if is32bit {
+		t.Skip("math/rand/v2 uses another algorithm on 32-bit platforms")
+	}
+	testCases := []uint64{1, 2, 3, 6, 1 << 32, 1<<32 + 1, 1 << 63, 1<<63 + 1, math.MaxUint64}
+	for _, n := range testCases {
+		prng := mt.New(mt19937.New(19650218))
+		rnd := rand.New(mt19937.New(19650218))
+		for i := 0; i < 1000; i++ {
+			r, res := prng.Uint64N(n), rnd.Uint64N(n)
+			if r != res {
+				t.Errorf("PRNG.Uint64N(%v) = %v, want %v.", n, r, res)
+				break
+			}
+			if r >= n {
+				t.Errorf("PRNG.Uint64N(%v) = %v, want < %v.", n, r, n)
+				break
+			}
+		}
+	}
+	prng := mt.New(mt19937.New(19650218))
+	rnd := rand.New(mt19937.New(19650218))
+	for i := 0; i < 1000; i++ {
+		if r, res := prng.Int64N(1000000007), rnd.Int64N(1000000007); r != res {
+			t.Errorf("PRNG.Int64N() = %v, want %v.", r, res)
+			break
+		}
+		if r, res := prng.IntN(12345), rnd.IntN(12345); r != res {
+			t.Errorf("PRNG.IntN() = %v, want %v.", r, res)
+			break
+		}
+	}
+}
+
+func TestUniformity(t *testing.T) {
+	// n is slightly less than 2^63; "Uint64() % n" is biased heavily
+	const n = uint64(1<<63 + 1<<62)
+	prng := mt.New(mt19937.New(19650218))
+	ct := 0
+	const total = 100000
+	for i := 0; i < total; i++ {
+		if prng.Uint64N(n) < n/2 {
+			ct++
+		}
+	}
+	// binomial(total, 0.5); 5 sigma
+	if math.Abs(float64(ct)-total/2) > 5*math.Sqrt(total)/2 {
+		t.Errorf("PRNG.Uint64N(%v) < %v: %v times in %v, want about %v.", n, n/2, ct, total, total/2)
+	}
+
+	counts := [7]int{}
+	for i := 0; i < 70000; i++ {
+		counts[prng.Uint32N(7)]++
+	}
+	chi2 := 0.0
+	for _, c := range counts {
+		chi2 += float64((c-10000)*(c-10000)) / 10000
+	}
+	if chi2 > 22.46 { // df=6, p=0.001
+		t.Errorf("PRNG.Uint32N(7): chi-squared statistic = %v (%v), want <= %v.", chi2, counts, 22.46)
+	}
+}
+
+func TestIntRange(t *testing.T) {
+	prng := mt.New(mt19937.New(19650218))
+	testCases := []struct {
+		lo, hi int
+	}{
+		{lo: 0, hi: 0},
+		{lo: -3, hi: 3},
+		{lo: 10, hi: 12},
+		{lo: math.MinInt, hi: math.MaxInt},
+		{lo: math.MinInt, hi: math.MinInt + 1},
+		{lo: math.MaxInt - 1, hi: math.MaxInt},
+	}
+	for _, tc := range testCases {
+		seen := map[int]bool{}
+		for i := 0; i < 1000; i++ {
+			r := prng.IntRange(tc.lo, tc.hi)
+			if r < tc.lo || r > tc.hi {
+				t.Errorf("PRNG.IntRange(%v, %v) = %v, out of range.", tc.lo, tc.hi, r)
+				break
+			}
+			seen[r] = true
+		}
+		if uint64(tc.hi-tc.lo) < 10 && len(seen) != tc.hi-tc.lo+1 {
+			t.Errorf("PRNG.IntRange(%v, %v) generates %v values, want %v.", tc.lo, tc.hi, len(seen), tc.hi-tc.lo+1)
+		}
+	}
+}
+
+func TestGenerics(t *testing.T) {
+	prng := mt.New(mt19937.New(19650218))
+	src := mt19937.New(19650218)
+	for i := 0; i < 1000; i++ {
+		if r, res := mt.N(prng, uint8(200)), mt.N(src, uint8(200)); r != res || r >= 200 {
+			t.Errorf("N[uint8]() = %v, want %v.", r, res)
+			break
+		}
+		if r, res := mt.N(prng, int16(3000)), mt.N(src, int16(3000)); r != res || r < 0 || r >= 3000 {
+			t.Errorf("N[int16]() = %v, want %v.", r, res)
+			break
+		}
+		if r, res := mt.Range(prng, int8(-128), int8(127)), mt.Range(src, int8(-128), int8(127)); r != res {
+			t.Errorf("Range[int8]() = %v, want %v.", r, res)
+			break
+		}
+		if r, res := mt.Range(prng, uint32(5), uint32(9)), mt.Range(src, uint32(5), uint32(9)); r != res || r < 5 || r > 9 {
+			t.Errorf("Range[uint32]() = %v, want %v.", r, res)
+			break
+		}
+		if r, res := mt.Range(prng, int64(math.MinInt64), int64(math.MaxInt64)), mt.Range(src, int64(math.MinInt64), int64(math.MaxInt64)); r != res {
+			t.Errorf("Range[int64]() = %v, want %v.", r, res)
+			break
+		}
+	}
+}
+
+func TestIntNConcurrency(t *testing.T) {
+	prng := mt.New(mt19937.New(19650218))
+	wg := sync.WaitGroup{}
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				if r := prng.IntRange(1, 6); r < 1 || r > 6 {
+					t.Errorf("PRNG.IntRange(1, 6) = %v, out of range.", r)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestIntNPanic(t *testing.T) {
+	prng := mt.New(mt19937.New(19650218))
+	testCases := []struct {
+		name string
+		f    func()
+	}{
+		{name: "Uint64N(0)", f: func() { prng.Uint64N(0) }},
+		{name: "Uint32N(0)", f: func() { prng.Uint32N(0) }},
+		{name: "Int64N(-1)", f: func() { prng.Int64N(-1) }},
+		{name: "IntN(0)", f: func() { prng.IntN(0) }},
+		{name: "IntRange(1, 0)", f: func() { prng.IntRange(1, 0) }},
+		{name: "N(0)", f: func() { mt.N(prng, 0) }},
+		{name: "Range(1, 0)", f: func() { mt.Range(prng, 1, 0) }},
+	}
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("PRNG.%s does not panic.", tc.name)
+				}
+			}()
+			tc.f()
+		}()
+	}
+}
+
+func TestIntNNil(t *testing.T) {
+	prng := (*mt.PRNG)(nil)
+	if r := prng.Uint64N(10); r != 0 {
+		t.Errorf("<nil>.Uint64N() = %v, want %v.", r, 0)
+	}
+	if r := prng.Uint32N(10); r != 0 {
+		t.Errorf("<nil>.Uint32N() = %v, want %v.", r, 0)
+	}
+	if r := prng.IntRange(1, 10); r != 1 {
+		t.Errorf("<nil>.IntRange() = %v, want %v.", r, 1)
+	}
+	if r := mt.N(prng, 10); r != 0 {
+		t.Errorf("N(<nil>) = %v, want %v.", r, 0)
+	}
+	if r := mt.Range(prng, -10, -1); r != -10 {
+		t.Errorf("Range(<nil>) = %v, want %v.", r, -10)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
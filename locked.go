@@ -0,0 +1,126 @@
+package mt
+
+import (
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+)
+
+// Locked is class of concurrency-safe pseudo random number generator like PRNG,
+// but it keeps the concrete type S of the source instead of Source interface.
+// It has the basic methods of PRNG only (Uint64, Real, Float64, SeedArray and NewReader);
+// use PRNG for the others.
+type Locked[S Source] struct {
+	source S
+	mutex  sync.Mutex
+	gen    atomic.Uint64 //generation of the source; incremented by seeding
+}
+
+var _ rand.Source = (*Locked[Source])(nil) //Locked is compatible with rand.Source and rand.Source64 interface
+var _ Source = (*Locked[Source])(nil)      //Locked is compatible with Source interface
+
+// NewLocked returns new Locked instance.
+func NewLocked[S Source](s S) *Locked[S] {
+	return &Locked[S]{source: s}
+}
+
+// SeedArray initializes the source with seeds array.
+func (l *Locked[S]) SeedArray(seeds []uint64) {
+	if l == nil {
+		return
+	}
+	l.mutex.Lock()
+	l.source.SeedArray(seeds)
+	l.gen.Add(1)
+	l.mutex.Unlock()
+}
+
+// Uint64 generates a random number on [0, 2^64-1]-interval.
+func (l *Locked[S]) Uint64() (n uint64) {
+	if l == nil {
+		return 0
+	}
+	l.mutex.Lock()
+	n = l.source.Uint64()
+	l.mutex.Unlock()
+	return
+}
+
+// Real generates a random number
+// on [0,1]-real-interval if mode==1,
+// on [0,1)-real-interval if mode==2,
+// on (0,1)-real-interval others
+//
+// Deprecated: use Locked.Float64 method instead.
+func (l *Locked[S]) Real(mode int) (f float64) {
+	if l == nil {
+		return 0
+	}
+	l.mutex.Lock()
+	f = l.source.Real(mode)
+	l.mutex.Unlock()
+	return
+}
+
+// Float64 generates a random number on the interval iv.
+func (l *Locked[S]) Float64(iv Interval) (f float64) {
+	if l == nil {
+		return 0
+	}
+	l.mutex.Lock()
+	f = float64Of(l.source, iv)
+	l.mutex.Unlock()
+	return
+}
+
+// NewReader returns new Reader instance with buffer of DefaultReaderSize bytes.
+func (l *Locked[S]) NewReader() *Reader {
+	return l.NewReaderSize(DefaultReaderSize)
+}
+
+// NewReaderSize returns new Reader instance whose buffer has at least the specified size in bytes
+// (rounded up to a multiple of 8).
+func (l *Locked[S]) NewReaderSize(size int) *Reader {
+	if l == nil {
+		return newReaderSize(nil, size)
+	}
+	return newReaderSize(l, size)
+}
+
+// fill fills buf (length is a multiple of 8) with random numbers in little endian, under a single lock.
+// It returns the generation of the source.
+func (l *Locked[S]) fill(buf []byte) (gen uint64) {
+	l.mutex.Lock()
+	fillBytes(l.source, buf)
+	gen = l.gen.Load()
+	l.mutex.Unlock()
+	return
+}
+
+// generation returns the generation of the source.
+func (l *Locked[S]) generation() uint64 {
+	return l.gen.Load()
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
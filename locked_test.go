@@ -0,0 +1,103 @@
+package mt
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestLocked(t *testing.T) {
+	prng := New(&splitMix{})
+	locked := NewLocked(&splitMix{})
+	prng.SeedArray([]uint64{19650218})
+	locked.SeedArray([]uint64{19650218})
+	for i := 0; i < 1000; i++ {
+		if r, res := locked.Uint64(), prng.Uint64(); r != res {
+			t.Errorf("Locked.Uint64() = %v, want %v.", r, res)
+		}
+		if r, res := locked.Real(i%3), prng.Real(i%3); r != res {
+			t.Errorf("Locked.Real() = %v, want %v.", r, res)
+		}
+		if r, res := locked.Float64(Interval(i%4)), prng.Float64(Interval(i%4)); r != res {
+			t.Errorf("Locked.Float64() = %v, want %v.", r, res)
+		}
+		if r, res := N(locked, i+1), N(prng, i+1); r != res {
+			t.Errorf("N(Locked) = %v, want %v.", r, res)
+		}
+	}
+}
+
+func TestLockedReader(t *testing.T) {
+	prng := New(&splitMix{x: 1})
+	locked := NewLocked(&splitMix{x: 1})
+	buf1, buf2 := make([]byte, 10000), make([]byte, 10000)
+	if _, err := locked.NewReader().Read(buf1); err != nil {
+		t.Errorf("Locked.NewReader().Read() is \"%v\", want nil.", err)
+	}
+	if _, err := prng.NewReader().Read(buf2); err != nil {
+		t.Errorf("PRNG.NewReader().Read() is \"%v\", want nil.", err)
+	}
+	if !bytes.Equal(buf1, buf2) {
+		t.Error("Locked.NewReader().Read() is not the same as PRNG.NewReader().Read().")
+	}
+}
+
+func TestLockedConcurrency(t *testing.T) {
+	src := &countSource{id: 1}
+	locked := NewLocked(src)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				_ = locked.Uint64()
+			}
+		}()
+	}
+	wg.Wait()
+	if src.ct != 100000 {
+		t.Errorf("Locked.Uint64() is called %v times, want %v.", src.ct, 100000)
+	}
+}
+
+func TestLockedNil(t *testing.T) {
+	locked := (*Locked[*splitMix])(nil)
+	locked.SeedArray(nil)
+	if r := locked.Uint64(); r != 0 {
+		t.Errorf("Locked.Uint64() = %v, want %v.", r, 0)
+	}
+	if r := locked.Real(0); r != 0 {
+		t.Errorf("Locked.Real() = %v, want %v.", r, 0)
+	}
+	if r := locked.Float64(ClosedOpen); r != 0 {
+		t.Errorf("Locked.Float64() = %v, want %v.", r, 0)
+	}
+	if _, err := locked.NewReader().Read(make([]byte, 8)); !errors.Is(err, ErrNilReader) {
+		t.Errorf("Locked.NewReader().Read() is \"%v\", want \"%v\".", err, ErrNilReader)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,74 @@
+package mt19937
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/goark/mt/v2/gf2"
+)
+
+// discardThreshold is the minimum distance for which Discard method uses the jump polynomial.
+const discardThreshold = 1 << 22
+
+// charModulus returns the characteristic polynomial of MT19937-64 as gf2.Modulus.
+var charModulus = sync.OnceValue(func() *gf2.Modulus {
+	return gf2.NewModulus(charPoly[:])
+})
+
+// Discard advances the state of Source by n steps (calls of Uint64 method).
+// If n is large, it computes x^n mod the characteristic polynomial
+// and takes O(log n) polynomial operations instead of generating n random numbers.
+func (s *Source) Discard(n uint64) {
+	if s == nil || n == 0 {
+		return
+	}
+	if s.mti >= nn+1 {
+		s.Seed(5489) // a default initial seed is used
+	}
+	s.pos += n
+	if n >= discardThreshold {
+		s.jump(charModulus().ExpX(new(big.Int).SetUint64(n)))
+		return
+	}
+	for n > 0 {
+		if s.mti >= nn {
+			s.generate()
+		}
+		k := min(uint64(nn-s.mti), n)
+		s.mti += int(k)
+		n -= k
+	}
+}
+
+// Position returns the number of random numbers generated (or discarded by Discard method)
+// since Source was seeded, modulo 2^64.
+// Jump and LongJump methods do not change it.
+func (s *Source) Position() uint64 {
+	if s == nil {
+		return 0
+	}
+	return s.pos
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
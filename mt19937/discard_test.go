@@ -0,0 +1,151 @@
+package mt19937
+
+import "testing"
+
+func TestDiscard(t *testing.T) {
+	testCases := []struct {
+		skip int
+		n    uint64
+	}{
+		{skip: 0, n: 0},
+		{skip: 0, n: 1},
+		{skip: 0, n: 311},
+		{skip: 0, n: 312},
+		{skip: 1, n: 312},
+		{skip: 311, n: 1},
+		{skip: 312, n: 1000},
+		{skip: 5, n: 19937},
+		{skip: 0, n: discardThreshold - 1},
+		{skip: 0, n: discardThreshold},
+		{skip: 100, n: discardThreshold + 12345},
+	}
+	for _, tc := range testCases {
+		rnd1 := NewWithArray([]uint64{0x12345, 0x23456, 0x34567, 0x45678})
+		rnd2 := NewWithArray([]uint64{0x12345, 0x23456, 0x34567, 0x45678})
+		for i := 0; i < tc.skip; i++ {
+			_ = rnd1.Uint64()
+			_ = rnd2.Uint64()
+		}
+		rnd1.Discard(tc.n)
+		for i := uint64(0); i < tc.n; i++ {
+			_ = rnd2.Uint64()
+		}
+		if p, want := rnd1.Position(), uint64(tc.skip)+tc.n; p != want {
+			t.Errorf("Source.Position() after Discard(%v) (skip %v) = %v, want %v.", tc.n, tc.skip, p, want)
+		}
+		for i := 0; i < 1000; i++ {
+			if r, res := rnd1.Uint64(), rnd2.Uint64(); r != res {
+				t.Errorf("Source.Discard(%v) (skip %v): %v-th value = %v, want %v.", tc.n, tc.skip, i, r, res)
+				break
+			}
+		}
+	}
+}
+
+func TestDiscardLarge(t *testing.T) {
+	const n = 1000000000000 // 10^12
+	rnd1 := New(19650218)
+	rnd1.Discard(n)
+	rnd2 := New(19650218)
+	for i := 0; i < 4; i++ {
+		rnd2.Discard(n / 4)
+	}
+	if p := rnd1.Position(); p != n {
+		t.Errorf("Source.Position() = %v, want %v.", p, uint64(n))
+	}
+	for i := 0; i < 1000; i++ {
+		if r, res := rnd1.Uint64(), rnd2.Uint64(); r != res {
+			t.Errorf("Source.Discard(%v): %v-th value = %v, want %v.", uint64(n), i, r, res)
+			break
+		}
+	}
+}
+
+func TestPosition(t *testing.T) {
+	rnd := New(19650218)
+	if p := rnd.Position(); p != 0 {
+		t.Errorf("Source.Position() = %v, want 0.", p)
+	}
+	for i := 0; i < 1000; i++ {
+		_ = rnd.Uint64()
+	}
+	_ = rnd.Float64Full()
+	if p := rnd.Position(); p != 1001 {
+		t.Errorf("Source.Position() = %v, want 1001.", p)
+	}
+	rnd.Jump()
+	if p := rnd.Position(); p != 1001 {
+		t.Errorf("Source.Position() after Jump() = %v, want 1001.", p)
+	}
+	rnd.Seed(1)
+	if p := rnd.Position(); p != 0 {
+		t.Errorf("Source.Position() after Seed() = %v, want 0.", p)
+	}
+}
+
+func TestDiscardEmpty(t *testing.T) {
+	rnd1 := &Source{mt: [nn]uint64{}, mti: nn + 1}
+	rnd1.Discard(100)
+	rnd2 := New(5489)
+	for i := 0; i < 100; i++ {
+		_ = rnd2.Uint64()
+	}
+	if r, res := rnd1.Uint64(), rnd2.Uint64(); r != res {
+		t.Errorf("Source.Discard() (empty) = %v, want %v.", r, res)
+	}
+	if p := rnd1.Position(); p != 101 {
+		t.Errorf("Source.Position() (empty) = %v, want 101.", p)
+	}
+}
+
+func TestDiscardNil(t *testing.T) {
+	var rnd *Source
+	rnd.Discard(100)
+	if p := rnd.Position(); p != 0 {
+		t.Errorf("Source.Position() (nil) = %v, want 0.", p)
+	}
+}
+
+func BenchmarkDiscardStep(b *testing.B) {
+	rnd := New(19650218)
+	for i := 0; i < b.N; i++ {
+		rnd.Discard(discardThreshold - 1)
+	}
+}
+
+func BenchmarkDiscardJump(b *testing.B) {
+	rnd := New(19650218)
+	for i := 0; i < b.N; i++ {
+		rnd.Discard(discardThreshold)
+	}
+}
+
+func BenchmarkDiscardLarge(b *testing.B) {
+	rnd := New(19650218)
+	for i := 0; i < b.N; i++ {
+		rnd.Discard(1000000000000)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand/v2"
 
+	"github.com/goark/mt/v2"
 	"github.com/goark/mt/v2/mt19937"
 )
 
@@ -13,6 +14,63 @@ func ExampleNew() {
 	//13735441942630277712
 }
 
+func ExampleSource_Jump() {
+	src := mt19937.New(19650218)
+	prngs := make([]*mt.PRNG, 3)
+	for i := range prngs {
+		s := *src
+		prngs[i] = mt.New(&s) // non-overlapping subsequences (2^128 steps each)
+		src.Jump()
+	}
+	for _, prng := range prngs {
+		fmt.Println(prng.Uint64())
+	}
+	//Output:
+	//13735441942630277712
+	//9186367621443152430
+	//12793933727307671002
+}
+
+func ExampleSource_Discard() {
+	src := mt19937.New(19650218)
+	src.Discard(1000000000000) // skip 10^12 random numbers
+	fmt.Println(src.Position())
+	fmt.Println(src.Uint64())
+	//Output:
+	//1000000000000
+	//4447946842964546122
+}
+
+func ExampleSource_Prev() {
+	src := mt19937.New(19650218)
+	for i := 0; i < 3; i++ {
+		fmt.Println(src.Uint64())
+	}
+	for i := 0; i < 3; i++ {
+		fmt.Println(src.Prev()) // in reverse order
+	}
+	//Output:
+	//13735441942630277712
+	//10468394322237346228
+	//5051557175812687784
+	//5051557175812687784
+	//10468394322237346228
+	//13735441942630277712
+}
+
+func ExampleSource_FillUint64s() {
+	prng := mt.New(mt19937.New(19650218))
+	buf := make([]uint64, 3)
+	prng.FillUint64s(buf) // under a single lock, using Source.FillUint64s
+	for _, r := range buf {
+		fmt.Println(r)
+	}
+	//Output:
+	//13735441942630277712
+	//10468394322237346228
+	//5051557175812687784
+}
+
 /* MIT License
  *
  * Copyright 2019-2024 Spiegel
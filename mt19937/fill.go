@@ -0,0 +1,95 @@
+package mt19937
+
+import (
+	"encoding/binary"
+
+	"github.com/goark/mt/v2"
+)
+
+// FillUint64s fills buf with random numbers (same as Uint64 method called len(buf) times).
+func (s *Source) FillUint64s(buf []uint64) {
+	if s == nil {
+		return
+	}
+	for len(buf) > 0 {
+		ws := s.words(len(buf))
+		for i, x := range ws {
+			buf[i] = temper(x)
+		}
+		buf = buf[len(ws):]
+	}
+}
+
+// FillFloat64s fills buf with random numbers on the interval iv
+// (same as Float64 method called len(buf) times).
+func (s *Source) FillFloat64s(buf []float64, iv mt.Interval) {
+	if s == nil {
+		return
+	}
+	for len(buf) > 0 {
+		ws := s.words(len(buf))
+		for i, x := range ws {
+			buf[i] = iv.Float64(temper(x))
+		}
+		buf = buf[len(ws):]
+	}
+}
+
+// FillBytes fills buf with random numbers in little endian.
+// If len(buf) is not a multiple of 8, the last random number is truncated.
+func (s *Source) FillBytes(buf []byte) {
+	if s == nil {
+		return
+	}
+	for len(buf) >= 8 {
+		ws := s.words(len(buf) / 8)
+		for _, x := range ws {
+			binary.LittleEndian.PutUint64(buf, temper(x))
+			buf = buf[8:]
+		}
+	}
+	if len(buf) > 0 {
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], s.Uint64())
+		copy(buf, b[:])
+	}
+}
+
+// words returns at most n state words to be tempered, and advances the index.
+// It regenerates the state vector if all words are used.
+func (s *Source) words(n int) []uint64 {
+	if s.mti >= nn {
+		if s.mti >= nn+1 {
+			s.Seed(5489) // a default initial seed is used
+		}
+		s.generate()
+	}
+	n = min(n, nn-s.mti)
+	ws := s.mt[s.mti : s.mti+n]
+	s.mti += n
+	s.pos += uint64(n)
+	return ws
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
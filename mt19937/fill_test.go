@@ -0,0 +1,113 @@
+package mt19937
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/goark/mt/v2"
+)
+
+func TestFillUint64s(t *testing.T) {
+	for _, skip := range []int{0, 1, 311, 312} {
+		for _, n := range []int{0, 1, 311, 312, 313, 1000} {
+			rnd1 := New(19650218)
+			rnd2 := New(19650218)
+			for i := 0; i < skip; i++ {
+				_ = rnd1.Uint64()
+				_ = rnd2.Uint64()
+			}
+			buf := make([]uint64, n)
+			rnd1.FillUint64s(buf)
+			for i, r := range buf {
+				if res := rnd2.Uint64(); r != res {
+					t.Errorf("Source.FillUint64s() (skip %v, n %v): %v-th value = %v, want %v.", skip, n, i, r, res)
+					break
+				}
+			}
+			if p1, p2 := rnd1.Position(), rnd2.Position(); p1 != p2 {
+				t.Errorf("Source.Position() after FillUint64s() = %v, want %v.", p1, p2)
+			}
+			if r, res := rnd1.Uint64(), rnd2.Uint64(); r != res {
+				t.Errorf("Source.Uint64() after FillUint64s() = %v, want %v.", r, res)
+			}
+		}
+	}
+}
+
+func TestFillFloat64s(t *testing.T) {
+	for _, iv := range []mt.Interval{mt.Open, mt.Closed, mt.ClosedOpen, mt.OpenClosed} {
+		rnd1 := New(19650218)
+		rnd2 := New(19650218)
+		buf := make([]float64, 1000)
+		rnd1.FillFloat64s(buf, iv)
+		for i, r := range buf {
+			if res := rnd2.Float64(iv); r != res {
+				t.Errorf("Source.FillFloat64s(%v): %v-th value = %v, want %v.", iv, i, r, res)
+				break
+			}
+		}
+	}
+}
+
+func TestFillBytes(t *testing.T) {
+	for _, n := range []int{0, 1, 7, 8, 9, 2495, 2496, 2497, 10000} {
+		rnd1 := New(19650218)
+		rnd2 := New(19650218)
+		buf := make([]byte, n)
+		rnd1.FillBytes(buf)
+		res := make([]byte, 0, n+8)
+		for len(res) < n {
+			res = binary.LittleEndian.AppendUint64(res, rnd2.Uint64())
+		}
+		if !bytes.Equal(buf, res[:n]) {
+			t.Errorf("Source.FillBytes() (n %v) is not the same as Uint64 in little endian.", n)
+		}
+		if r, res := rnd1.Uint64(), rnd2.Uint64(); r != res {
+			t.Errorf("Source.Uint64() after FillBytes() (n %v) = %v, want %v.", n, r, res)
+		}
+	}
+}
+
+func TestFillEmpty(t *testing.T) {
+	rnd := &Source{mt: [nn]uint64{}, mti: nn + 1}
+	buf := make([]uint64, 1)
+	rnd.FillUint64s(buf)
+	if res := New(5489).Uint64(); buf[0] != res {
+		t.Errorf("<empty>.FillUint64s() = %v, want %v.", buf[0], res)
+	}
+}
+
+func TestFillNil(t *testing.T) {
+	var rnd *Source
+	buf := []uint64{1}
+	rnd.FillUint64s(buf)
+	rnd.FillFloat64s(make([]float64, 1), mt.ClosedOpen)
+	rnd.FillBytes(make([]byte, 1))
+	if buf[0] != 1 {
+		t.Errorf("<nil>.FillUint64s() changes buf: %v.", buf[0])
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,104 @@
+package mt19937_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/goark/mt/v2"
+	"github.com/goark/mt/v2/mt19937"
+	"github.com/goark/mt/v2/mt19937/recover"
+)
+
+// syntheticSource returns Source which generates words in order (untempered by recover.Untemper).
+func syntheticSource(t *testing.T, words ...uint64) *mt19937.Source {
+	t.Helper()
+	b := []byte{}
+	for i := 0; i < 312; i++ {
+		var x uint64
+		if i < len(words) {
+			x = recover.Untemper(words[i])
+		}
+		b = strconv.AppendUint(b, x, 10)
+		b = append(b, ' ')
+	}
+	b = append(b, '0') // index of the next word
+	s := &mt19937.Source{}
+	if err := s.UnmarshalText(b); err != nil {
+		t.Fatalf("Source.UnmarshalText() is \"%v\", want nil.", err)
+	}
+	return s
+}
+
+func TestRealInterval(t *testing.T) {
+	testCases := []struct {
+		iv       mt.Interval
+		mode     int
+		min, max float64
+	}{
+		{iv: mt.Closed, mode: 1, min: 0, max: 1},
+		{iv: mt.ClosedOpen, mode: 2, min: 0, max: 1 - 0x1p-53},
+		{iv: mt.OpenClosed, mode: -1, min: 0x1p-53, max: 1},
+		{iv: mt.Open, mode: 0, min: 0x1p-53, max: 1 - 0x1p-53},
+		{iv: mt.Open, mode: 3, min: 0x1p-53, max: 1 - 0x1p-53},
+	}
+	for _, tc := range testCases {
+		s := syntheticSource(t, 0, ^uint64(0))
+		if f := s.Float64(tc.iv); f != tc.min {
+			t.Errorf("Source.Float64(%v) with all-zero word = %v, want %v.", tc.iv, f, tc.min)
+		}
+		if f := s.Float64(tc.iv); f != tc.max {
+			t.Errorf("Source.Float64(%v) with all-one word = %v, want %v.", tc.iv, f, tc.max)
+		}
+		if tc.mode < 0 {
+			continue
+		}
+		s = syntheticSource(t, 0, ^uint64(0))
+		if f := s.Real(tc.mode); f != tc.min {
+			t.Errorf("Source.Real(%v) with all-zero word = %v, want %v.", tc.mode, f, tc.min)
+		}
+		if f := s.Real(tc.mode); f != tc.max {
+			t.Errorf("Source.Real(%v) with all-one word = %v, want %v.", tc.mode, f, tc.max)
+		}
+	}
+	if f := (*mt19937.Source)(nil).Float64(mt.Closed); f != 0 {
+		t.Errorf("<nil>.Float64() = \"%v\", want \"%v\".", f, 0)
+	}
+}
+
+func TestFloat32(t *testing.T) {
+	for _, iv := range []mt.Interval{mt.Closed, mt.ClosedOpen, mt.OpenClosed, mt.Open} {
+		s := syntheticSource(t, 0, ^uint64(0))
+		if f, res := s.Float32(iv), iv.Float32(0); f != res {
+			t.Errorf("Source.Float32(%v) with all-zero word = %v, want %v.", iv, f, res)
+		}
+		if f, res := s.Float32(iv), iv.Float32(^uint64(0)); f != res {
+			t.Errorf("Source.Float32(%v) with all-one word = %v, want %v.", iv, f, res)
+		}
+	}
+	if f := (*mt19937.Source)(nil).Float32(mt.Closed); f != 0 {
+		t.Errorf("<nil>.Float32() = \"%v\", want \"%v\".", f, 0)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
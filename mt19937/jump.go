@@ -0,0 +1,102 @@
+package mt19937
+
+//go:generate go run jumppoly_gen.go
+
+// Jump advances the state of Source by 2^128 steps (calls of Uint64 method).
+// It can be used to generate 2^64 non-overlapping subsequences for parallel computations.
+func (s *Source) Jump() {
+	s.jump(jumpPoly[:])
+}
+
+// LongJump advances the state of Source by 2^192 steps (calls of Uint64 method).
+// It can be used to generate 2^(19937-192) starting points,
+// from each of which Jump method will generate 2^64 non-overlapping subsequences.
+func (s *Source) LongJump() {
+	s.jump(longJumpPoly[:])
+}
+
+// jumpState is a state vector of MT19937-64 generating random numbers one by one.
+type jumpState struct {
+	mt [nn]uint64
+	i  int
+}
+
+// next advances the state by one step.
+func (js *jumpState) next() {
+	i1 := js.i + 1
+	if i1 >= nn {
+		i1 -= nn
+	}
+	im := js.i + mm
+	if im >= nn {
+		im -= nn
+	}
+	x := (js.mt[js.i] & upperMask) | (js.mt[i1] & lowerMask)
+	js.mt[js.i] = js.mt[im] ^ (x >> 1) ^ matrixA[(int)(x&0x01)]
+	js.i = i1
+}
+
+// add sets js = js + s (on GF(2)).
+func (js *jumpState) add(s *jumpState) {
+	i, j := js.i, s.i
+	for k := 0; k < nn; k++ {
+		js.mt[i] ^= s.mt[j]
+		if i++; i >= nn {
+			i = 0
+		}
+		if j++; j >= nn {
+			j = 0
+		}
+	}
+}
+
+// jump advances the state of Source by the polynomial jp (jp(x) = x^step mod charPoly).
+func (s *Source) jump(jp []uint64) {
+	if s == nil {
+		return
+	}
+	if s.mti >= nn+1 {
+		s.Seed(5489) // a default initial seed is used
+	}
+	// state vector mt[0..nn) is the one before regeneration,
+	// and the next random number is generated from mt[mti] (or new mt[0] if mti==nn).
+	st := &jumpState{mt: s.mt, i: 0}
+	acc := &jumpState{}
+	for i := len(jp)*64 - 1; i >= 0; i-- {
+		acc.next()
+		if (jp[i/64]>>(i%64))&0x01 != 0 {
+			acc.add(st)
+		}
+	}
+	for i := 0; i < nn; i++ {
+		j := acc.i + i
+		if j >= nn {
+			j -= nn
+		}
+		s.mt[i] = acc.mt[j]
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel, based on the jump-ahead method for Mersenne Twister.
+ * (http://www.math.sci.hiroshima-u.ac.jp/m-mat/MT/JUMP/index.html)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,149 @@
+package mt19937
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/goark/mt/v2/gf2"
+)
+
+// xPower returns x^n mod charPoly
+func xPower(n int) []uint64 {
+	return charModulus().ExpX(big.NewInt(int64(n)))
+}
+
+// sqrMod returns p^2 mod charPoly
+func sqrMod(p []uint64) []uint64 {
+	return charModulus().Sqr(p)
+}
+
+// TestCharPoly recomputes the characteristic polynomial from the output of Source.
+func TestCharPoly(t *testing.T) {
+	const n = 2 * 19937
+	seq := make([]uint64, n/64+1)
+	src := NewWithArray([]uint64{0x12345, 0x23456, 0x34567, 0x45678})
+	for i := 0; i < n; i++ {
+		seq[i/64] |= (src.Uint64() & 1) << (i % 64)
+	}
+	if p := gf2.BerlekampMassey(seq, n); !p.Equal(charPoly[:]) {
+		t.Errorf("minimal polynomial of output (degree %v) is not charPoly.", p.Degree())
+	}
+}
+
+func TestJumpByStep(t *testing.T) {
+	testCases := []struct {
+		skip int
+		step int
+	}{
+		{skip: 0, step: 1},
+		{skip: 0, step: 311},
+		{skip: 0, step: 312},
+		{skip: 0, step: 313},
+		{skip: 1, step: 311},
+		{skip: 5, step: 1000},
+		{skip: 312, step: 312},
+		{skip: 313, step: 19937},
+		{skip: 100, step: 19937 + 1000},
+		{skip: 0, step: 100000},
+	}
+	for _, tc := range testCases {
+		rnd1 := NewWithArray([]uint64{0x12345, 0x23456, 0x34567, 0x45678})
+		rnd2 := NewWithArray([]uint64{0x12345, 0x23456, 0x34567, 0x45678})
+		for i := 0; i < tc.skip; i++ {
+			_ = rnd1.Uint64()
+			_ = rnd2.Uint64()
+		}
+		rnd1.jump(xPower(tc.step))
+		for i := 0; i < tc.step; i++ {
+			_ = rnd2.Uint64()
+		}
+		for i := 0; i < 1000; i++ {
+			if r, res := rnd1.Uint64(), rnd2.Uint64(); r != res {
+				t.Errorf("Source.jump(%v) (skip %v): %v-th value = %v, want %v.", tc.step, tc.skip, i, r, res)
+				break
+			}
+		}
+	}
+}
+
+func TestJumpPoly(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+	p := xPower(1)
+	for i := 1; i <= 192; i++ {
+		p = sqrMod(p)
+		switch i {
+		case 128:
+			if [nn]uint64(p) != jumpPoly {
+				t.Error("jumpPoly is not x^(2^128) mod charPoly.")
+			}
+		case 192:
+			if [nn]uint64(p) != longJumpPoly {
+				t.Error("longJumpPoly is not x^(2^192) mod charPoly.")
+			}
+		}
+	}
+}
+
+func TestJump(t *testing.T) {
+	rnd1 := New(19650218)
+	rnd2 := New(19650218)
+	rnd1.Jump()
+	rnd1.Jump()
+	rnd2.jump(sqrMod(jumpPoly[:]))
+	for i := 0; i < 1000; i++ {
+		if r, res := rnd1.Uint64(), rnd2.Uint64(); r != res {
+			t.Errorf("Source.Jump(): %v-th value = %v, want %v.", i, r, res)
+			break
+		}
+	}
+	rnd3 := New(19650218)
+	rnd3.LongJump()
+	rnd4 := New(19650218)
+	p := jumpPoly[:]
+	for i := 0; i < 64; i++ {
+		p = sqrMod(p)
+	}
+	rnd4.jump(p)
+	for i := 0; i < 1000; i++ {
+		if r, res := rnd3.Uint64(), rnd4.Uint64(); r != res {
+			t.Errorf("Source.LongJump(): %v-th value = %v, want %v.", i, r, res)
+			break
+		}
+	}
+}
+
+func TestJumpEmpty(t *testing.T) {
+	rnd1 := &Source{mt: [nn]uint64{}, mti: nn + 1}
+	rnd1.Jump()
+	rnd2 := New(5489)
+	rnd2.Jump()
+	if r, res := rnd1.Uint64(), rnd2.Uint64(); r != res {
+		t.Errorf("<empty>.Jump() = \"%v\", want \"%v\".", r, res)
+	}
+	(*Source)(nil).Jump() //no panic
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
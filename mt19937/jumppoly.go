@@ -0,0 +1,249 @@
+// Code generated by jumppoly_gen.go; DO NOT EDIT.
+
+package mt19937
+
+// charPoly is the characteristic polynomial of MT19937-64.
+var charPoly = [312]uint64{
+	0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000,
+	0x0100000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000100000,
+	0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000,
+	0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000,
+	0x0000000000000000, 0x0000000000000010, 0x0000000000000000, 0x0000000100000000,
+	0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000,
+	0x0000000000000000, 0x0000000000000000, 0x0010000000000000, 0x0000000000000000,
+	0x0000000000000000, 0x0000000000010000, 0x0000000000000000, 0x0000100000000000,
+	0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000,
+	0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000001,
+	0x0000000000000000, 0x0000000010000000, 0x0000000000000000, 0x0100000000000000,
+	0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000,
+	0x0001000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000001000,
+	0x0000000000000000, 0x0000010000000000, 0x0000000000000000, 0x0000000000000000,
+	0x0000000000000010, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000,
+	0x1000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000001000000,
+	0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000,
+	0x0000000000010000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000,
+	0x0000000000000000, 0x0000000000000100, 0x0000000000000000, 0x0000000000000000,
+	0x0000000000000000, 0x0000000000000000, 0x0000000000000001, 0x0000000000000000,
+	0x0000000000000000, 0x0000000000000000, 0x0080000000000000, 0x0000000000000000,
+	0x0000000000000000, 0x0000000000100000, 0x0000000000000000, 0x0001a00000000000,
+	0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000,
+	0x0000000000000000, 0x0000000000000000, 0x4000000000000000, 0x0000000000000010,
+	0x0000000000000000, 0x0000000124000000, 0x0000000000000000, 0x1050000000000000,
+	0x0000000000000000, 0x0000000000000000, 0x0000000001058000, 0x0000000000000000,
+	0x0000400000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000010480,
+	0x0000000000000000, 0x0000004100000000, 0x0000000000000000, 0x1800000000000000,
+	0x0000000000000104, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000,
+	0x0008000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000010110000,
+	0x0000000000000000, 0x0001980000000000, 0x0000000000000000, 0x0000000000000000,
+	0x0000000000100004, 0x0000000000000000, 0x0001008860000000, 0x0000000000000000,
+	0x0400000000000000, 0x0000000000001001, 0x0000000000000000, 0x0000000018400000,
+	0x0000000000000000, 0x0000400000000000, 0x0000000000000000, 0x0000000000000000,
+	0x0000000000082600, 0x0000000000000000, 0x0001005000000000, 0x0000000000000000,
+	0x8000000000000000, 0x0000000001001805, 0x0000000000000000, 0x0000000040000000,
+	0x0000000000000000, 0x04a0000000000000, 0x0000000000010008, 0x0000000000000000,
+	0x0000000000400000, 0x0000000000000000, 0x0004000000000000, 0x0000000000000000,
+	0x0000000000000000, 0x0000000000000040, 0x0000000000000000, 0x0000022600000000,
+	0x0000000000000001, 0x4000000000000000, 0x0000000000000010, 0x0000000000000000,
+	0x0080000184000000, 0x0000000000000000, 0x0040000000000000, 0x0000000000000004,
+	0x0000000000000000, 0x0000a00060a40000, 0x0000000000000000, 0x0400400000000000,
+	0x0000000000000000, 0x0000000000000000, 0x0000000000400400, 0x0000000000000000,
+	0x4000404000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000024002624,
+	0x0000000000000000, 0x0050005040000000, 0x0000000000000000, 0x8400000000000000,
+	0x0000000000058005, 0x0000000000000000, 0x0000400040400000, 0x0000000000000000,
+	0x04a4000000000000, 0x0000000000000480, 0x0000000000000000, 0x0000004100404000,
+	0x0000000000000000, 0x1804040000000000, 0x0000000000000004, 0x0000000000000000,
+	0x0000000000000040, 0x0000000000000000, 0x0008022400000000, 0x0000000000000000,
+	0x4000000000000000, 0x0000000000110010, 0x0000000000000000, 0x0001980184000000,
+	0x0000000000000000, 0x0040000000000000, 0x0000000000000004, 0x0000000000000000,
+	0x0000008860a40000, 0x0000000000000000, 0x0400400000000000, 0x0000000000000001,
+	0x0000000000000000, 0x0000000018400400, 0x0000000000000000, 0x0000404000000000,
+	0x0000000000000000, 0x0000000000000000, 0x0000000000082624, 0x0000000000000000,
+	0x0001005040000000, 0x0000000000000000, 0x8400000000000000, 0x0000000000001805,
+	0x0000000000000000, 0x0000000040400000, 0x0000000000000000, 0x04a4000000000000,
+	0x0000000000000008, 0x0000000000000000, 0x0000000000404000, 0x0000000000000000,
+	0x0004040000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000040,
+	0x0000000000000000, 0x0000022400000000, 0x0000000000000000, 0x4000000000000000,
+	0x0000000000000010, 0x0000000000000000, 0x0000000184000000, 0x0000000000000000,
+	0x0040000000000000, 0x0000000000000004, 0x0000000000000000, 0x0000000060a40000,
+	0x0000000000000000, 0x0400400000000000, 0x0000000000000000, 0x0000000000000000,
+	0x0000000000400400, 0x0000000000000000, 0x0000404000000000, 0x0000000000000000,
+	0x0000000000000000, 0x0000000000002624, 0x0000000000000000, 0x0000005040000000,
+	0x0000000000000000, 0x8400000000000000, 0x0000000000000005, 0x0000000000000000,
+	0x0000000040400000, 0x0000000000000000, 0x04a4000000000000, 0x0000000000000000,
+	0x0000000000000000, 0x0000000000404000, 0x0000000000000000, 0x0004040000000000,
+	0x0000000000000000, 0x0000000000000000, 0x0000000000000040, 0x0000000000000000,
+	0x0000022400000000, 0x0000000000000000, 0x4000000000000000, 0x0000000000000010,
+	0x0000000000000000, 0x0000000184000000, 0x0000000000000000, 0x0040000000000000,
+	0x0000000000000000, 0x0000000000000000, 0x0000000000a40000, 0x0000000000000000,
+	0x0000400000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000400,
+	0x0000000000000000, 0x0000004000000000, 0x0000000000000000, 0x0000000000000000,
+	0x0000000000000024, 0x0000000000000000, 0x0000000040000000, 0x0000000000000000,
+	0x0400000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000400000,
+	0x0000000000000000, 0x0004000000000000, 0x0000000000000000, 0x0000000000000000,
+	0x0000000000004000, 0x0000000000000000, 0x0000040000000000, 0x0000000000000000,
+	0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000200000000,
+}
+
+// jumpPoly is x^(2^128) mod charPoly.
+var jumpPoly = [312]uint64{
+	0x153fbc23409b1e30, 0xb8d58a2efc1cc7be, 0x04cc8df6bd5573e1, 0x8e1b99d6ea322754,
+	0x7fa5c8ab11a78ecf, 0xa3f01992f879dc26, 0x77500e62929d74d1, 0x4c65ef439f2dcb2a,
+	0x731b3bd3538eec46, 0x14cd564c40c9e3ae, 0x6ff65677752268b7, 0xbbea104c48ec8b8d,
+	0x08d3565972568ea4, 0x5cb79db1f77395f2, 0x94f5c348a32cecac, 0x4b58cc38b6123ed7,
+	0x64d191a00b3e362c, 0x7b051615bc105659, 0x2ad11e2d812e15d2, 0xd2551d15c944f218,
+	0x68374254d1f46885, 0x72a5fd7700e8c34f, 0xe40b4ac61e14376c, 0xbb107cd0a9158cc0,
+	0x5028a2a3d4ce28e6, 0xd0815eeb2e91aa05, 0x29ba386f6309e7dd, 0xa19bf128091df643,
+	0xa4dda3ea5af247f8, 0x950ff2c8bc8d9f30, 0xc415a0871ef1af4e, 0xe8859d7a5ac3264c,
+	0x4d58e6bed0739fe2, 0xb072d474e3f9602c, 0x93b112035cf0e33d, 0x90d4af56420a0a3d,
+	0xcb930cdffd09ba87, 0x82305413c76ba04a, 0x88ed61ba7dfc9075, 0xdefc75a7869c145c,
+	0x0c16916696775659, 0x94a47bf0b5d3869b, 0x026c4476e2551799, 0x2b22d90027fdd747,
+	0xe447af7718644777, 0xbb83f1c03190e0fa, 0x932fabc717b3114c, 0xe0384041dbd5eafd,
+	0x698ca9a2304fa895, 0xbbb26eff4e2f6627, 0x453cab967a470645, 0x2a6aefabcd19d4e9,
+	0x808f8d33240f6b90, 0x91bf46c93a4b852b, 0x74b6a8597100e697, 0xbd2a4ef239564089,
+	0x9917718e08ec24fa, 0xac9ce650dccc5d61, 0x52db4d76a2c5546c, 0x0123e0fc3cb90aea,
+	0xfe78f1e83bb93635, 0x4f5b739d5ba04851, 0xa4bf7f96e9684a89, 0x5464bb377a97f62e,
+	0x328933f006ce14be, 0x43e558b7d62ae5d7, 0xddb0f33f21e7d8dc, 0x52d2779de93320d2,
+	0x57191c72acfc5093, 0x1779384819ca00e9, 0x7afcfbbe2acaa684, 0x90231d57884a7544,
+	0xdd3ffead4feec6e3, 0x273584a42f1a795d, 0x691601338d2c7449, 0x8c8e419ca0529fc3,
+	0x373e37dd051f8b86, 0x27a2d7161f6d06bd, 0x954240070472311a, 0x471565b60a93d2e4,
+	0x4fb4ad962c328135, 0x7b1a3a92c401e93b, 0xf261c3fcc82af141, 0x57241af08978f3ec,
+	0x2c79aaa370d1bd4f, 0xf35790a0978137d6, 0x38c7263c96234239, 0xe0a13a1dd5f852b5,
+	0x0734f6c962f86802, 0xca52564f72f13f11, 0xa4bd2a9dc69a1248, 0x6f418a04edb45e98,
+	0x764b57a0059aa71a, 0x926f6f5f354266df, 0x60c4150013cc9412, 0x3a14980c9d4ccd96,
+	0x4e5da33944239d8b, 0x23f3ef6e843c729c, 0x389b1022de0ac7c9, 0x369b29d7d285823e,
+	0xf556214ad63e2cd9, 0x90e43b9536bc15ab, 0xa43604007e23fd84, 0x70ee2bd8d9e6c2af,
+	0x0e8b6c7a77fd426a, 0xed09417ce0d73cdf, 0xa3e935e2c81a4021, 0x7cf2e08b288398fa,
+	0x1e933cde96a31115, 0xdb6014c3a780c561, 0x2bf15950b4660f9d, 0x50cf62efc80a3c55,
+	0x448ede02ea0783c5, 0x97df0d14f64c01c7, 0x1353357d543368d0, 0x9bd1449652cdca9c,
+	0x66d15aefa7a24321, 0x25dd75fc7492ba9d, 0x468ce9a1a3874e13, 0x40ab9e8ed67a4ad1,
+	0x0bafb4d323d02677, 0xf9f3d01c1f435b69, 0x0c4a0fa46fac656a, 0xbdac3abdd37e4dfc,
+	0xdf9b06ef05db31df, 0xed005f00f37daa7b, 0x924be2e465b09410, 0x99099376ea87be57,
+	0x302d8a7c49c4be6a, 0xe8effc70541c07a5, 0x6e4611ad196a6ee3, 0xbd42cb15a52cb228,
+	0xce343ee493cdec20, 0x7f4231e3d20e8e72, 0xa2127d2ed81e4f89, 0x27bb32afa1c6ef4c,
+	0x9d37d9f4cb87c492, 0xa6b7e94b15e2287c, 0x098b4d302e16d6e9, 0x12d1da8ffbf3adb2,
+	0xd5be155bc2fc01de, 0x90f630b9e309715b, 0xbdb108b0f8da213c, 0x98ed520d71f49d1a,
+	0x82495aacd19eb9dc, 0x124d7478a15025b2, 0xa0eb607ec4087775, 0xcb47955eeabe0890,
+	0x7360a3d0e0b68b89, 0x25f5bee656159d92, 0xeae8434e13f985ed, 0x04ff38722ad10a86,
+	0xac7097215b434280, 0x3640ae9dd0687b1a, 0xb24209a4ce9f603b, 0xf03e6fd6f7a416dd,
+	0xd31e5bcde48672af, 0x2704ce60eb8429a7, 0xf7aeb81f8fcd00c3, 0x5424dbaa0b636a3c,
+	0xf352fe250d625a64, 0x9cc12556c2228f86, 0xedac0dbb94e94f51, 0xdd8f2b1f26762fd1,
+	0x5ef488076c7e957f, 0x2b734dc8a46c3c61, 0x52111589eb2a22e3, 0xfa11c9bb843df4bc,
+	0x5896ac2ecf36f9d2, 0x66c197a7e49dba0a, 0xe1eda2cd47aefd0f, 0x4cae0acf5d5fa62d,
+	0xcb3e21e3f8d7c943, 0x351580d27b75fe44, 0x6cbd4b5618cbab9b, 0x8e47ef0542e8a51d,
+	0x125adf6b4b59b2ef, 0x2729dc334cacfd5b, 0x883432a737937820, 0x60f002c1dceda4ab,
+	0xafed1be46e7fd2bc, 0xf2a3d1ccbf871115, 0xf85e5c5050ae7160, 0x777cdc44554e6d74,
+	0x0bcf75213e259946, 0x9d0714b4db9ca29a, 0x370fdc4067326a6d, 0xffeb713807a1cea8,
+	0x7fb0a9674a53e792, 0x62b040005f9ce7bb, 0x8903f6b282b67cab, 0x3544ff158026eb52,
+	0xd66590248adf92f1, 0x55de1c87a2ebdf48, 0x40b0382287267aba, 0x7dfa56a6fb26180e,
+	0x45c32d7dc66b19ce, 0xf5ed0edf665034c7, 0xf4c7adbe75e15da0, 0x95db8535e0bd9122,
+	0xc571b09620d82713, 0x9c21ed0e78f021f9, 0xd0cb50a9f9aa8def, 0xbcb3368c4e9ff5b6,
+	0x06d8f649704939a3, 0x5eaa9ee186d14a54, 0x86d1f972fd4883d0, 0x63b1522f4d50d887,
+	0x982b2fba1a9875a7, 0x7258bfd6235930ea, 0xe4ccc8e3c2f0f70e, 0x9bf390d119769362,
+	0x1bcea29dbd2c02be, 0xd9c189db413398c0, 0x988aa44564f85434, 0x007ed1eaeef5e20a,
+	0xa0685fede0eec596, 0xfef177e0b35a7f0e, 0x5006596f191ebc61, 0xcba87c3e61bdbc8a,
+	0xff2174049069bfcb, 0xd7a536ddb2c4f33f, 0xf7aecde21fc2d977, 0xc121dca3feef7800,
+	0xa90ad927d025c16b, 0x3ea6fee532058e96, 0x9f5210df30acdeb9, 0x520e94889837bcff,
+	0x8c6c6a100dabdb5b, 0x6d2101f3fc530774, 0x51d535e6dc645e49, 0xe5e7620ed6a4941b,
+	0xaf8023c107046243, 0x62e6e40f4ea19600, 0x466396ce1ab8e939, 0x470fc344d01a2a69,
+	0x223011f816549f0e, 0x9b0a401733299c57, 0x6e214523ae60b334, 0x84c4cbe45a9b66a6,
+	0x630d39f922b4c0b4, 0xfbfa79ec2c0e1012, 0xe9940485ec80d5c0, 0x1dc1c6fb5a01f32a,
+	0x9cd0b7f3a578e57f, 0x40b6ce9d50e92c04, 0x588b8af39ab91d81, 0x8058dc2783b02de3,
+	0xbb2103c504392c9d, 0x7264692220716211, 0xdb804fcdeb987bba, 0xababd32a49398687,
+	0xe3dee3755b4da875, 0x16de733adb8bb721, 0x99476d13103ffe32, 0x86d2d629666cb05b,
+	0x9c4e62ab740ce645, 0xb59682265b7519ff, 0x54df6930e9ed43fb, 0x33f8218861f98b68,
+	0x21bc749542f06516, 0xd5e9662b4586df7f, 0x465569ea0eb5cce4, 0x36a484c938f0ae75,
+	0xc088cc5189f80399, 0x4becd1a8a2280cde, 0x192f20a74dac06f0, 0xae766a8b287a1565,
+	0x036c05ba6abff5f3, 0x5fe448493d8faf69, 0xa880a8ff94b90ea8, 0xd0ec7c6342d2b77b,
+	0xd187d7068a2cf90f, 0x32523f9ad82e6693, 0x0f87420e87b90726, 0x3a745f953d8e0c35,
+	0x0199993c5a3d1db4, 0x33e45b5766ccb1a0, 0xd2abaac1626e0b0c, 0xad5c3023b061fdfb,
+	0xf67cf6541cb66e52, 0xe9d9083c635a2190, 0x29a103e0c3b4dac8, 0x75f72adb5e7a7e46,
+	0xdcc943ab2ec296da, 0x396a079f137ff14b, 0x67853f3d29182ec1, 0x35dd3e7a7a71c780,
+	0xfbf82a6fa275a546, 0x39cc58a7583f7227, 0x8b1b1aedefea9fed, 0x909f457dada71450,
+	0xc02abfcbfe3e387a, 0xd6871e18b79ae3c1, 0x9f6bac46344f1a0f, 0x3366cd78201abced,
+	0xa9da4a5207175299, 0x030642baf1ad5022, 0x5ae120669a844ab0, 0xd8fc12c876b5dbb7,
+	0x2f92b413a6fc6e34, 0x2f2b5a6b0f30aff4, 0x89633b161fac757a, 0x5e4bf21ca2b399c2,
+	0x5ed834f955dcf6ab, 0xd5fdc80d6fa8e6cd, 0xcdf09ed99544069f, 0xfa9adc855e53297c,
+	0x38fa314d5c46ab53, 0x94508c05dda26a06, 0x7de2dae2aa415d2c, 0x0000000143ed6f2e,
+}
+
+// longJumpPoly is x^(2^192) mod charPoly.
+var longJumpPoly = [312]uint64{
+	0xdc26f6b43a6d0469, 0x6ff0871801b56874, 0x7f1e36d790bfd7e0, 0xf4df205a6940b709,
+	0x8d4a45cf24d1a963, 0xc8982e8b3b83404c, 0x9c12e6f496847c7d, 0xcb412fafe797697e,
+	0x6e528babf735a68a, 0xc830f7a3bb560353, 0xf80ef2882b0c5bbb, 0x959939de824293a5,
+	0x1e83c5bdb94c266c, 0x411f3e96ea0ea4cb, 0x86d577dcd798562d, 0x4f976b9f0b34a7b2,
+	0x4fccfcda81d52fa3, 0xc2d6c2ee379c3abb, 0x2befdbd3dc173cab, 0xdf8adbe4192a0f8c,
+	0x2c70942ebf57fa1f, 0x88150cbe7f1e4bb3, 0x74cb569efaef3aec, 0xc6557e59afedc7aa,
+	0x406cf699694d15a6, 0x8fe096521166ace9, 0xfba8eda31eb3fd5c, 0x1d6061af6aef756b,
+	0x9a20493fed66600e, 0x27ec92b5b12316b8, 0xccfa7e303b622fe7, 0x217e3cc50766aeb3,
+	0xab0f7557ec4e65d9, 0x12f58afb14ea9d73, 0xedbb9b49a20e2451, 0xb2536eead184f26d,
+	0x151a132aef9f6b44, 0x7b960dc2ca322845, 0xd72054b7d98ce671, 0x09fd849cceef45d6,
+	0x6b516ce110d0ef6a, 0xd2186a6666563c72, 0x0c9e1b6d362d9bf7, 0x14a98f87d556e8ba,
+	0x1f468202abc914c8, 0xc076274be6034851, 0xeb67d162796f2073, 0x36f7435a3d28827f,
+	0x7a534858d728dca3, 0x951d160cd527cbc0, 0x92670ad8945408d8, 0x15841295f775a027,
+	0x61ec6a4185af9e74, 0x971829e50f4380f1, 0x8f7c405079cc9b73, 0x6d63f0b35a44b2a1,
+	0x0338e9a14a737f9b, 0x1cf6ef7fe7eaed79, 0x3d48a0ab75c66e30, 0x1453cfa4372c987b,
+	0x06c389c168e86443, 0xabf6474417ae6c4d, 0x7871a056069abbbb, 0xb181f7f5e4e244eb,
+	0x402ad1f2ddb90dd3, 0x8a957772768d891f, 0xa41a228613c5c244, 0xd64fe3aee829346a,
+	0x9719c3868017aa9b, 0xf487011d190a35c8, 0x2eb10fc61b394188, 0xc3054958562a5293,
+	0x5adeee280d4abb18, 0x2f817b3c44cf7fba, 0xcc1250cd325fcbdc, 0x8904c642cc5eb6e1,
+	0xf230f8f3e761e7c3, 0xbbea865b0286ac8d, 0x70bc06e56c97f62f, 0x493035949c5c5415,
+	0x30d500005deaa3d4, 0x03c5636978263054, 0x509fbaba10db9fa2, 0x8290a8833463bb94,
+	0xf9b514551f4c8e4b, 0xc738e4fad14ca944, 0x6086399693451ece, 0x6a274f4e61fbfcec,
+	0x14c4f58c5381d0f5, 0xc8586e0e793b9fdc, 0x9e599b5449191369, 0x8501f024e8175284,
+	0xd7f8d06174580911, 0x325ec39b518b1eda, 0x46a1d2237e6b2142, 0xfed7b4adcc365834,
+	0x3b773297e3075b76, 0x562c34fe34875efc, 0x4922deca9f54489d, 0xc7077de5372bedf2,
+	0xdc56090976f1d372, 0x78693be375b3fd7c, 0xdc851f24c27f1967, 0x49b019a5c384c8e4,
+	0x9002cf9f889771bc, 0x33eda60fddbc56f4, 0x2a8bb7993b3c89a6, 0x57ac90e244350026,
+	0xaad84f59838d5336, 0x8a6cb7b72ae30a44, 0x4063c07a18cdf45c, 0xbd5910ae875b6fd7,
+	0xc310511dd6e6651e, 0x7813d04d3d8328fa, 0x338dfe55bac75dbd, 0x07728470daa122a6,
+	0xd4c991bfefb49cb8, 0xade168f9c55e6d74, 0x62ea6c1d73d3911a, 0x6bb2ed6adfe964d2,
+	0xbc52ad6a1128b941, 0x8a00a68b8638b916, 0x02949163bf46289d, 0xc3a3e634ce9869f3,
+	0xc6a575bc5e68a5cd, 0x7777249ccc6af41d, 0x5a2e4de6dbd887bc, 0xaa29632df6960fc2,
+	0x9dd0249f062868a5, 0xd511416a86cfdf33, 0x869982f52d1ec583, 0x15d943327cc08252,
+	0x0e79857afddb111b, 0x8f25b170c236530a, 0xf49317b77a105dd5, 0x79abec6fb829d043,
+	0x921e6a60cac43faa, 0xca7c493f642a69ab, 0x803194e1aea29d58, 0x8c42ff60615a08bd,
+	0x6d5a39d37d3a532c, 0x6d3651e6b9ecf4b3, 0xf2eb6c8b153201e6, 0xcfac1331027271a1,
+	0xea1f43fda506ce44, 0x0449fc5c874f0067, 0x7c3666947d3ad50c, 0x9fdc53753cc97f9d,
+	0xb321fe9a6a0c6552, 0x91382dfb5fefc7b0, 0xa017b7c91e8b7239, 0x141938e144df1db6,
+	0x04b85f2f16ceed26, 0xa3dbaca4ca4e0fee, 0x68045e4c0f1e3aad, 0x09843c0611a9a1b4,
+	0x3224e5b57db57e33, 0xa93fe705ad71d143, 0x02394ee059a01747, 0xcf436eb0e3b1eba3,
+	0x59f79919a1123861, 0xa8766eb4ccbabf60, 0x487debf28d066920, 0x50ece0f99579bc26,
+	0xf2960a310f53660f, 0x486cb0b76635a627, 0xdf2dd367f9edb6cc, 0xdea4f2a3e32b55f0,
+	0x9885afd1beecb4a3, 0xb568deee3a58a90d, 0xe473e0cf40603994, 0x754c12400681d4b2,
+	0xebf82a452dd39245, 0xfdbfc0c783c9f792, 0x6ea1f2c886353c78, 0x5a0eecf22d48229b,
+	0x483f87538e3066f3, 0x700f7805a8a7cc38, 0x499409d28281ad2b, 0xbb199407a013dd7a,
+	0x695d8cd2fb528526, 0x2546daa4f6fe2162, 0xa892784f2a3f9220, 0x84acfc4399acec11,
+	0x9d3cbb20f4d9c3ff, 0xaa28ffe37506db52, 0x1856da0aeca90fd3, 0x97aaf3e16f64a89b,
+	0xe2e8d5f495bd1745, 0x69e0ff2fc3185f42, 0xc66b2519c4f2784e, 0xda1856d569c81ff5,
+	0x8fe9cc7cc9c42f1d, 0xcd0ef9efcf0e5d0c, 0xddc9b05386e50b4d, 0xfc6f912f6fbce571,
+	0xc6f06a8d9b0b12f7, 0x59b45443af4ffae9, 0x580c282920c561eb, 0xebc6bfbeaf82232e,
+	0x7e9721eca0670a6d, 0xe8c8ca8216c85ea7, 0x7551ef118deda8f3, 0xf5b240379710b2e6,
+	0xf46086c2510f8f11, 0x9e72c1c51033c4c3, 0xa3d1ff72d80f4302, 0x5f98ff792964c5b2,
+	0x9d8ad224bb181974, 0x53dcaa13b810ba46, 0x55a45d6cedac0ee8, 0x262c6414c25a1548,
+	0x9795a5093fb9442c, 0xdeacbc5d58849e37, 0x2c53326d5fc4f5ac, 0x97d9b7d46290f85d,
+	0x25bbaf104b30521c, 0xd8b32058ed12d42f, 0x10ae6e23ef1ee3ce, 0xabb14ef219730bf9,
+	0x031a9f6427245414, 0xc1e748018cc84943, 0x549b5ca3a2169fd0, 0x2612f5bd67d8374f,
+	0xd6617df7ae67dd46, 0x5e85a109ca624827, 0x40c2f38a772cb3dd, 0x9b99a1a77367161a,
+	0x466c9c198dab9adc, 0x0e7164e7cc79a7cf, 0x11d2b64275c4756a, 0x2a5fd83af2a55693,
+	0x63ff15bd8c622b1f, 0xa62becde1bfa14f5, 0x2f391c107e2a9fc5, 0xd09cbd29dcc907df,
+	0x438d506e24959816, 0x8291c7810c693356, 0x532afedf269ee8b0, 0x0cf764c97a55742f,
+	0x0f958159063e4ece, 0x764fa3f90b8d5b2c, 0x8acaa16457644911, 0x815791525e736820,
+	0xf8d0ce2cbd27a126, 0xc0d13f88acde9472, 0x5246cde4e59e7bfe, 0x604e833acb72fb53,
+	0x698a2c4e0821a4a7, 0xe493812f5d6c60b9, 0x53476754836faa7f, 0xa1181fb9d5175512,
+	0xf0d13ce8dd41e712, 0xafe5cbdb9b94fca7, 0xb0d3197243e0ecf1, 0xe0007e589ffdcc4f,
+	0xc5794f2e357746f7, 0x6a92e32182f3183a, 0x5bb2ef1a38cc035c, 0xcbc20a84a4666ba4,
+	0x12a4d85909226013, 0x8368400e21f7e634, 0x98c8712a9ad07ce1, 0x346d9845ab115917,
+	0x2d229c1045289cf6, 0x09b1d5b66b45e7e3, 0x0538e992673d5e6f, 0xb130708bb93c1637,
+	0x11ee9de5b0a08a85, 0x9ebe4b7f35c9daff, 0xf175f7e8f86f7f79, 0xbca331cd43b47906,
+	0xfc3fa1f0965fa1cf, 0x7454bd07e70aa0d5, 0x41ec41087757fc92, 0xcc3306f204d261ca,
+	0x621f598f2de51460, 0xe3ffb2b9ec1d2f97, 0xd9e24e04954c93be, 0x670f28a08ff667b7,
+	0x903954044a35ce72, 0x01abb48fc560b956, 0xf93e1e0fe5cde247, 0x3c62a8304206f15f,
+	0xc31f831c7880001d, 0xce21fb535b73f4e6, 0x24251f0c3ab35bce, 0x4ed5ff20b4d1e000,
+	0x71bead4d532a2332, 0xe1c68a88ab296602, 0xccf762c8c0495d11, 0x63df8e0f0320f850,
+	0x7da5977c4e2547c3, 0x628d3263f69e54b9, 0xcf911421cf2123a7, 0xe448d2122381cc6c,
+	0x2ea1059f5f4d5387, 0xbadd69362f180e52, 0xddc7b018f9612444, 0x0d83b513a3c845d5,
+	0x9333f5a25b5f176e, 0x04f3dfcc2de8a72c, 0x3284b3b9d6970a35, 0x67d6228da073f2ad,
+	0x801c6cf2648113f2, 0xf51454620015bcd0, 0x55b3f102a87a1b31, 0x5d63f052d6aa3d21,
+	0xa74567cc7dbf0288, 0x1e24c4aa126d05c1, 0x583b3982e8b2f8e2, 0x000000011e5622da,
+}
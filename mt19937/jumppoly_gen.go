@@ -0,0 +1,68 @@
+//go:build ignore
+// +build ignore
+
+// This program generates jumppoly.go.
+// It computes the characteristic polynomial of MT19937-64 from its own output
+// (Berlekamp-Massey algorithm) and jump polynomials x^(2^128) and x^(2^192) modulo it.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+
+	"github.com/goark/mt/v2/gf2"
+	"github.com/goark/mt/v2/mt19937"
+)
+
+const mexp = 19937 //Mersenne exponent (degree of characteristic polynomial)
+
+func writePoly(buf *bytes.Buffer, name, comment string, p gf2.Poly, n int) {
+	fmt.Fprintf(buf, "// %s\nvar %s = [%d]uint64{", comment, name, n)
+	for i := 0; i < n; i++ {
+		if i%4 == 0 {
+			fmt.Fprint(buf, "\n")
+		}
+		w := uint64(0)
+		if i < len(p) {
+			w = p[i]
+		}
+		fmt.Fprintf(buf, "0x%016x, ", w)
+	}
+	fmt.Fprint(buf, "\n}\n\n")
+}
+
+func main() {
+	n := 2 * mexp
+	seq := make([]uint64, n/64+1)
+	src := mt19937.New(5489)
+	for i := 0; i < n; i++ {
+		seq[i/64] |= (src.Uint64() & 1) << (i % 64)
+	}
+	charPoly := gf2.BerlekampMassey(seq, n)
+	if charPoly.Degree() != mexp {
+		fmt.Fprintln(os.Stderr, "invalid degree of characteristic polynomial:", charPoly.Degree())
+		os.Exit(1)
+	}
+	words := mexp/64 + 1
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintln(buf, "// Code generated by jumppoly_gen.go; DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package mt19937")
+	fmt.Fprintln(buf)
+	writePoly(buf, "charPoly", "charPoly is the characteristic polynomial of MT19937-64.", charPoly, words)
+	md := gf2.NewModulus(charPoly)
+	writePoly(buf, "jumpPoly", "jumpPoly is x^(2^128) mod charPoly.", md.ExpX2(128), words)
+	writePoly(buf, "longJumpPoly", "longJumpPoly is x^(2^192) mod charPoly.", md.ExpX2(192), words)
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile("jumppoly.go", out, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
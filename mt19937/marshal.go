@@ -0,0 +1,111 @@
+package mt19937
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidState is returned when decoding an invalid state of Source.
+var ErrInvalidState = errors.New("invalid state of mt19937.Source")
+
+const (
+	marshalPrefix  = "mt19937:"
+	marshalVersion = 1
+	marshalSize    = len(marshalPrefix) + 1 + 2 + nn*8 //prefix + version + mti + state vector
+)
+
+var (
+	_ encoding.BinaryMarshaler   = (*Source)(nil) //Source is compatible with encoding.BinaryMarshaler interface
+	_ encoding.BinaryUnmarshaler = (*Source)(nil) //Source is compatible with encoding.BinaryUnmarshaler interface
+)
+
+// AppendBinary appends the binary encoding of the state of Source to b.
+func (s *Source) AppendBinary(b []byte) ([]byte, error) {
+	if s == nil {
+		return b, fmt.Errorf("%w: nil source", ErrInvalidState)
+	}
+	if s.mti >= nn+1 {
+		return New(5489).AppendBinary(b) // a default initial seed is used
+	}
+	b = append(b, marshalPrefix...)
+	b = append(b, marshalVersion)
+	b = binary.BigEndian.AppendUint16(b, uint16(s.mti))
+	for _, x := range s.mt {
+		b = binary.BigEndian.AppendUint64(b, x)
+	}
+	return b, nil
+}
+
+// MarshalBinary returns the binary encoding of the state of Source
+// (compatible with encoding.BinaryMarshaler interface).
+func (s *Source) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(make([]byte, 0, marshalSize))
+}
+
+// UnmarshalBinary restores the state of Source from the binary encoding
+// (compatible with encoding.BinaryUnmarshaler interface).
+// Position of Source is reset to 0 (it is not included in the encoding).
+func (s *Source) UnmarshalBinary(data []byte) error {
+	if s == nil {
+		return fmt.Errorf("%w: nil source", ErrInvalidState)
+	}
+	if len(data) != marshalSize || string(data[:len(marshalPrefix)]) != marshalPrefix {
+		return fmt.Errorf("%w: invalid encoding", ErrInvalidState)
+	}
+	data = data[len(marshalPrefix):]
+	if data[0] != marshalVersion {
+		return fmt.Errorf("%w: unsupported version %d", ErrInvalidState, data[0])
+	}
+	mti := int(binary.BigEndian.Uint16(data[1:]))
+	if mti > nn {
+		return fmt.Errorf("%w: index %d is out of range", ErrInvalidState, mti)
+	}
+	data = data[3:]
+	var mt [nn]uint64
+	for i := range mt {
+		mt[i] = binary.BigEndian.Uint64(data[i*8:])
+	}
+	if isZeroState(&mt) {
+		return fmt.Errorf("%w: all-zero state vector", ErrInvalidState)
+	}
+	s.mt, s.mti, s.pos = mt, mti, 0
+	return nil
+}
+
+// isZeroState reports whether the state vector is all zero (except for the unused bits).
+func isZeroState(mt *[nn]uint64) bool {
+	if mt[0]&upperMask != 0 {
+		return false
+	}
+	for _, x := range mt[1:] {
+		if x != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
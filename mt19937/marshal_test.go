@@ -0,0 +1,118 @@
+package mt19937
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	testCases := []struct {
+		skip int
+	}{
+		{skip: 0},
+		{skip: 1},
+		{skip: 311},
+		{skip: 312},
+		{skip: 313},
+		{skip: 1000},
+	}
+	for _, tc := range testCases {
+		rnd1 := NewWithArray([]uint64{0x12345, 0x23456, 0x34567, 0x45678})
+		for i := 0; i < tc.skip; i++ {
+			_ = rnd1.Uint64()
+		}
+		b, err := rnd1.MarshalBinary()
+		if err != nil {
+			t.Errorf("Source.MarshalBinary() is \"%v\", want nil.", err)
+			continue
+		}
+		rnd2 := New(0)
+		if err := rnd2.UnmarshalBinary(b); err != nil {
+			t.Errorf("Source.UnmarshalBinary() is \"%v\", want nil.", err)
+			continue
+		}
+		for i := 0; i < 1000; i++ {
+			if r, res := rnd2.Uint64(), rnd1.Uint64(); r != res {
+				t.Errorf("Source.Uint64() (skip %v): %v-th value = %v, want %v.", tc.skip, i, r, res)
+				break
+			}
+		}
+	}
+}
+
+func TestMarshalBinaryEmpty(t *testing.T) {
+	b1, err := (&Source{mt: [nn]uint64{}, mti: nn + 1}).MarshalBinary()
+	if err != nil {
+		t.Errorf("<empty>.MarshalBinary() is \"%v\", want nil.", err)
+	}
+	b2, _ := New(5489).MarshalBinary()
+	if !bytes.Equal(b1, b2) {
+		t.Errorf("<empty>.MarshalBinary() = %v, want %v.", b1, b2)
+	}
+	if _, err := (*Source)(nil).MarshalBinary(); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("<nil>.MarshalBinary() is \"%v\", want \"%v\".", err, ErrInvalidState)
+	}
+	if err := (*Source)(nil).UnmarshalBinary(b2); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("<nil>.UnmarshalBinary() is \"%v\", want \"%v\".", err, ErrInvalidState)
+	}
+}
+
+func TestUnmarshalBinaryErr(t *testing.T) {
+	valid, _ := New(19650218).MarshalBinary()
+	modify := func(f func(b []byte) []byte) []byte {
+		b := make([]byte, len(valid))
+		copy(b, valid)
+		return f(b)
+	}
+	testCases := []struct {
+		name string
+		data []byte
+	}{
+		{name: "nil", data: nil},
+		{name: "short", data: valid[:len(valid)-1]},
+		{name: "long", data: append(modify(func(b []byte) []byte { return b }), 0)},
+		{name: "prefix", data: modify(func(b []byte) []byte { b[0] = 'M'; return b })},
+		{name: "version", data: modify(func(b []byte) []byte { b[8] = 2; return b })},
+		{name: "index", data: modify(func(b []byte) []byte { b[9], b[10] = 0x01, 0x39; return b })}, //313
+		{name: "zero", data: modify(func(b []byte) []byte {
+			for i := 11; i < len(b); i++ {
+				b[i] = 0
+			}
+			b[18] = 0x01 //lower bits of mt[0] are not used
+			return b
+		})},
+	}
+	for _, tc := range testCases {
+		rnd := New(5489)
+		if err := rnd.UnmarshalBinary(tc.data); !errors.Is(err, ErrInvalidState) {
+			t.Errorf("Source.UnmarshalBinary(%s) is \"%v\", want \"%v\".", tc.name, err, ErrInvalidState)
+		}
+		if r, res := rnd.Uint64(), New(5489).Uint64(); r != res {
+			t.Errorf("Source.Uint64() after error = %v, want %v.", r, res)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
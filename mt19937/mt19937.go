@@ -13,9 +13,11 @@ const (
 type Source struct {
 	mt  [nn]uint64 //The array for the state vector
 	mti int        //mti==nn+1 means mt[nn] is not initialized
+	pos uint64     //number of random numbers generated since seeding
 }
 
 var _ mt.Source = (*Source)(nil) //Source is compatible with mt.Source interface
+var _ mt.Seeder = (*Source)(nil) //Source is compatible with mt.Seeder interface
 
 // New returns a new pseudo-random source seeded with the given value.
 func New(seed int64) *Source {
@@ -37,6 +39,7 @@ func (s *Source) Seed(seed int64) {
 		return
 	}
 	s.mt[0] = uint64(seed)
+	s.pos = 0
 	for s.mti = 1; s.mti < nn; s.mti++ {
 		s.mt[s.mti] = 6364136223846793005*(s.mt[s.mti-1]^(s.mt[s.mti-1]>>62)) + uint64(s.mti)
 	}
@@ -98,21 +101,17 @@ func (s *Source) Uint64() uint64 {
 		if s.mti >= 1+nn {
 			s.Seed(5489) // a default initial seed is used
 		}
-		for i := 0; i < nn-1; i++ {
-			x := (s.mt[i] & upperMask) | (s.mt[i+1] & lowerMask)
-			if i < (nn - mm) {
-				s.mt[i] = s.mt[i+mm] ^ (x >> 1) ^ matrixA[(int)(x&0x01)]
-			} else {
-				s.mt[i] = s.mt[i+(mm-nn)] ^ (x >> 1) ^ matrixA[(int)(x&0x01)]
-			}
-		}
-		x := (s.mt[nn-1] & upperMask) | (s.mt[0] & lowerMask)
-		s.mt[nn-1] = s.mt[mm-1] ^ (x >> 1) ^ matrixA[(int)(x&0x01)]
-		s.mti = 0
+		s.generate()
 	}
 
 	x := s.mt[s.mti]
 	s.mti++
+	s.pos++
+	return temper(x)
+}
+
+// temper returns the tempered value of the state word x.
+func temper(x uint64) uint64 {
 	x ^= (x >> 29) & 0x5555555555555555
 	x ^= (x << 17) & 0x71D67FFFEDA60000
 	x ^= (x << 37) & 0xFFF7EEE000000000
@@ -120,22 +119,53 @@ func (s *Source) Uint64() uint64 {
 	return x
 }
 
+// generate regenerates the whole state vector (nn words) at once.
+func (s *Source) generate() {
+	for i := 0; i < nn-1; i++ {
+		x := (s.mt[i] & upperMask) | (s.mt[i+1] & lowerMask)
+		if i < (nn - mm) {
+			s.mt[i] = s.mt[i+mm] ^ (x >> 1) ^ matrixA[(int)(x&0x01)]
+		} else {
+			s.mt[i] = s.mt[i+(mm-nn)] ^ (x >> 1) ^ matrixA[(int)(x&0x01)]
+		}
+	}
+	x := (s.mt[nn-1] & upperMask) | (s.mt[0] & lowerMask)
+	s.mt[nn-1] = s.mt[mm-1] ^ (x >> 1) ^ matrixA[(int)(x&0x01)]
+	s.mti = 0
+}
+
 // Real generates a random number
-// on [0,1)-real-interval if mode==1,
-// on (0,1)-real-interval if mode==2,
-// on [0,1]-real-interval others
+// on [0,1]-real-interval if mode==1 (genrand64_real1 function),
+// on [0,1)-real-interval if mode==2 (genrand64_real2 function),
+// on (0,1)-real-interval others (genrand64_real3 function)
+//
+// Deprecated: use Source.Float64 method instead.
 func (s *Source) Real(mode int) float64 {
+	return s.Float64(mt.ModeInterval(mode))
+}
+
+// Float64 generates a random number on the interval iv.
+func (s *Source) Float64(iv mt.Interval) float64 {
 	if s == nil {
 		return 0.0
 	}
-	switch mode {
-	case 1: //generates a random number on [0,1)-real-interval
-		return (float64)(s.Uint64()>>11) * (1.0 / 9007199254740991.0)
-	case 2: //generates a random number on (0,1)-real-interval
-		return (float64)(s.Uint64()>>11) * (1.0 / 9007199254740992.0)
-	default: //generates a random number on [0,1]-real-interval
-		return ((float64)(s.Uint64()>>12) + 0.5) * (1.0 / 4503599627370496.0)
+	return iv.Float64(s.Uint64())
+}
+
+// Float32 generates a random number (float32) on the interval iv.
+func (s *Source) Float32(iv mt.Interval) float32 {
+	if s == nil {
+		return 0.0
+	}
+	return iv.Float32(s.Uint64())
+}
+
+// Float64Full generates a random number on [0,1)-real-interval with full precision (see mt.Float64Full function).
+func (s *Source) Float64Full() float64 {
+	if s == nil {
+		return 0.0
 	}
+	return mt.Float64Full(s)
 }
 
 /* MIT License
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"testing"
+
+	"github.com/goark/mt/v2"
 )
 
 var referenceTextInt = ` 7266447313870364031  4946485549665804864 16945909448695747420 16394063075524226720  4873882236456199058
@@ -464,6 +466,56 @@ func TestNil(t *testing.T) {
 	}
 }
 
+func TestFloat64Full(t *testing.T) {
+	s := New(19650218)
+	sum := 0.0
+	for i := 0; i < 100000; i++ {
+		f := s.Float64Full()
+		if f < 0 || f >= 1 {
+			t.Fatalf("Source.Float64Full() = %v, want [0,1).", f)
+		}
+		sum += f
+	}
+	if mean := sum / 100000; mean < 0.49 || mean > 0.51 {
+		t.Errorf("mean of Source.Float64Full() = %v, want about 0.5.", mean)
+	}
+	if f := (*Source)(nil).Float64Full(); f != 0 {
+		t.Errorf("<nil>.Float64Full() = \"%v\", want \"%v\".", f, 0)
+	}
+}
+
+func TestPRNGSeed(t *testing.T) {
+	testCases := []struct {
+		name string
+		seed func(prng *mt.PRNG)
+		src  *Source
+	}{
+		{name: "Seed", seed: func(p *mt.PRNG) { p.Seed(19650218) }, src: New(19650218)},
+		{name: "SeedUint64", seed: func(p *mt.PRNG) { p.SeedUint64(1<<64 - 1) }, src: New(-1)},
+		{name: "SeedBytes", seed: func(p *mt.PRNG) { p.SeedBytes([]byte("passphrase")) }, src: NewWithArray(mt.SeedsFromBytes([]byte("passphrase")))},
+		{name: "SeedArray", seed: func(p *mt.PRNG) { p.SeedArray([]uint64{0x12345, 0x23456, 0x34567, 0x45678}) }, src: NewWithArray([]uint64{0x12345, 0x23456, 0x34567, 0x45678})},
+	}
+	for _, tc := range testCases {
+		prng := mt.New(New(0))
+		tc.seed(prng)
+		res := make([]uint64, 1000)
+		for i := range res {
+			res[i] = tc.src.Uint64()
+			if r := prng.Uint64(); r != res[i] {
+				t.Errorf("PRNG.%v(): %v-th value = %v, want %v.", tc.name, i, r, res[i])
+				break
+			}
+		}
+		prng.Reset()
+		for i := range res {
+			if r := prng.Uint64(); r != res[i] {
+				t.Errorf("PRNG.Reset() after %v(): %v-th value = %v, want %v.", tc.name, i, r, res[i])
+				break
+			}
+		}
+	}
+}
+
 /* MIT License
  *
  * Copyright 2019-2024 Spiegel
@@ -0,0 +1,84 @@
+package mt19937
+
+// Prev steps the state of Source back by one step and returns the random number
+// generated by the last call of Uint64 method, so the sequence is generated backwards.
+// A call of Uint64 method after Prev returns the same value again.
+// Prev can step back over the seeding point (the linear recurrence of MT19937-64 is extended backwards);
+// then the lower 31 bits of the first word of the initial state vector,
+// which never affect the output of Uint64 method, are replaced to be consistent with the recurrence.
+func (s *Source) Prev() uint64 {
+	if s == nil {
+		return 0
+	}
+	if s.mti >= nn+1 {
+		s.Seed(5489) // a default initial seed is used
+	}
+	if s.mti == 0 {
+		s.ungenerate()
+		s.mti = nn
+	}
+	s.mti--
+	s.pos--
+	if s.mti > 0 {
+		return temper(s.mt[s.mti])
+	}
+	// the lower bits of mt[0] are not determined by the recurrence just after seeding
+	s.fixFirst()
+	x := s.mt[0]
+	// same representation as Uint64 method (regeneration is deferred to the next call)
+	s.ungenerate()
+	s.mti = nn
+	return temper(x)
+}
+
+// ungenerate restores the state vector before the last regeneration (inverse of generate method).
+func (s *Source) ungenerate() {
+	// mt[i] (new) = mt[i+mm] ^ A(upper bits of mt[i] (old) | lower bits of mt[i+1] (old)),
+	// and the most significant bit of A(y) is the least significant bit of y.
+	// mt[(i+mm)%nn] is already restored if i+mm < nn (in descending order of i), or a new word otherwise.
+	for i := nn - 1; i >= 0; i-- {
+		y := untwist(s.mt[i], s.mt[(i+mm)%nn])
+		s.mt[i] = y & upperMask
+		if i+1 < nn {
+			s.mt[i+1] |= y & lowerMask
+		}
+	}
+	s.fixFirst()
+}
+
+// fixFirst restores the lower bits of mt[0] from the previous step of the recurrence
+// (mt[nn-1] = mt[mm-1] ^ A(upper bits of the previous word | lower bits of mt[0])).
+func (s *Source) fixFirst() {
+	s.mt[0] = s.mt[0]&upperMask | untwist(s.mt[nn-1], s.mt[mm-1])&lowerMask
+}
+
+// untwist returns y such that x == mid ^ A(y).
+func untwist(x, mid uint64) uint64 {
+	t := x ^ mid
+	odd := t >> 63
+	t ^= matrixA[odd]
+	return t<<1 | odd
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
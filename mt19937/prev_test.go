@@ -0,0 +1,172 @@
+package mt19937
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// sameState reports whether a and b have the same state.
+// The lower bits of mt[0] are ignored if mti==nn, since they are not used to generate random numbers.
+func sameState(a, b *Source) bool {
+	if a.mti != b.mti || a.pos != b.pos {
+		return false
+	}
+	for i := range a.mt {
+		x, y := a.mt[i], b.mt[i]
+		if i == 0 && a.mti == nn {
+			x, y = x&upperMask, y&upperMask
+		}
+		if x != y {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPrev(t *testing.T) {
+	for _, skip := range []int{0, 1, 155, 311, 312, 313, 1000} {
+		for _, n := range []int{1, 2, 156, 311, 312, 313, 624, 1000, 2000} {
+			rnd := NewWithArray([]uint64{0x12345, 0x23456, 0x34567, 0x45678})
+			for i := 0; i < skip; i++ {
+				_ = rnd.Uint64()
+			}
+			org := *rnd
+			res := make([]uint64, n)
+			for i := range res {
+				res[i] = rnd.Uint64()
+			}
+			for i := n - 1; i >= 0; i-- {
+				if r := rnd.Prev(); r != res[i] {
+					t.Errorf("Source.Prev() (skip %v, n %v): %v-th value = %v, want %v.", skip, n, i, r, res[i])
+					break
+				}
+			}
+			if !sameState(rnd, &org) {
+				t.Errorf("Source.Prev() (skip %v, n %v): state is not restored.", skip, n)
+			}
+			for i := range res {
+				if r := rnd.Uint64(); r != res[i] {
+					t.Errorf("Source.Uint64() after Prev() (skip %v, n %v): %v-th value = %v, want %v.", skip, n, i, r, res[i])
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestPrevBackward(t *testing.T) {
+	for _, n := range []int{1, 311, 312, 313, 1000, 2000} {
+		rnd := New(19650218)
+		for i := 0; i < 5000; i++ {
+			_ = rnd.Uint64()
+		}
+		org := *rnd
+		res := make([]uint64, n)
+		for i := range res {
+			res[i] = rnd.Prev()
+		}
+		if p := rnd.Position(); p != 5000-uint64(n) {
+			t.Errorf("Source.Position() after Prev() (n %v) = %v, want %v.", n, p, 5000-n)
+		}
+		for i := n - 1; i >= 0; i-- {
+			if r := rnd.Uint64(); r != res[i] {
+				t.Errorf("Source.Uint64() after Prev() (n %v): %v-th value = %v, want %v.", n, i, r, res[i])
+				break
+			}
+		}
+		if !sameState(rnd, &org) {
+			t.Errorf("Source.Uint64() after Prev() (n %v): state is not restored.", n)
+		}
+	}
+}
+
+func TestPrevRandomWalk(t *testing.T) {
+	const size = 5000
+	ref := New(19650218)
+	res := make([]uint64, size)
+	for i := range res {
+		res[i] = ref.Uint64()
+	}
+	rnd := New(19650218)
+	walk := rand.New(rand.NewPCG(1, 2))
+	pos := 0
+	for i := 0; i < 1000; i++ {
+		forward := walk.IntN(2) == 0
+		for n := walk.IntN(700); n > 0; n-- {
+			if forward && pos < size {
+				if r := rnd.Uint64(); r != res[pos] {
+					t.Fatalf("Source.Uint64() at %v = %v, want %v.", pos, r, res[pos])
+				}
+				pos++
+			} else if !forward && pos > 0 {
+				pos--
+				if r := rnd.Prev(); r != res[pos] {
+					t.Fatalf("Source.Prev() at %v = %v, want %v.", pos, r, res[pos])
+				}
+			}
+		}
+		if p := rnd.Position(); p != uint64(pos) {
+			t.Fatalf("Source.Position() = %v, want %v.", p, pos)
+		}
+	}
+}
+
+func TestPrevSeed(t *testing.T) {
+	// stepping back over the seeding point and forward again
+	rnd := New(19650218)
+	org := *rnd
+	for i := 0; i < 1000; i++ {
+		_ = rnd.Prev()
+	}
+	if p := rnd.Position(); p != 1<<64-1000 {
+		t.Errorf("Source.Position() after Prev() = %v, want %v.", p, uint64(1<<64-1000))
+	}
+	for i := 0; i < 1000; i++ {
+		_ = rnd.Uint64()
+	}
+	if !sameState(rnd, &org) {
+		t.Error("Source.Uint64() after Prev(): state is not restored.")
+	}
+	res := New(19650218).Uint64()
+	if r := rnd.Uint64(); r != res {
+		t.Errorf("Source.Uint64() after Prev() = %v, want %v.", r, res)
+	}
+}
+
+func TestPrevEmpty(t *testing.T) {
+	rnd := &Source{mt: [nn]uint64{}, mti: nn + 1}
+	r := rnd.Prev()
+	res := New(5489).Prev()
+	if r != res {
+		t.Errorf("<empty>.Prev() = %v, want %v.", r, res)
+	}
+}
+
+func TestPrevNil(t *testing.T) {
+	if r := (*Source)(nil).Prev(); r != 0 {
+		t.Errorf("<nil>.Prev() = %v, want 0.", r)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
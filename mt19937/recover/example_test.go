@@ -0,0 +1,77 @@
+package recover_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/goark/mt/v2"
+	"github.com/goark/mt/v2/mt19937"
+	"github.com/goark/mt/v2/mt19937/recover"
+)
+
+func Example() {
+	// a server issues "secret" tokens with an unknown seed
+	server := mt.New(mt19937.New(time.Now().UnixNano()))
+	// an attacker collects 312 tokens
+	tokens := make([]uint64, 312)
+	for i := range tokens {
+		tokens[i] = server.Uint64()
+	}
+	src, err := recover.Recover(tokens)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	// and predicts all the following tokens
+	ok := true
+	for i := 0; i < 1000; i++ {
+		ok = ok && src.Uint64() == server.Uint64()
+	}
+	fmt.Println(ok)
+	//Output:
+	//true
+}
+
+func ExampleRecoverPartial() {
+	server := mt.New(mt19937.New(time.Now().UnixNano()))
+	obs := make([]recover.Observation, 624)
+	for i := range obs {
+		o, err := recover.ObserveFloat64(server.Float64(mt.ClosedOpen), mt.ClosedOpen)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		obs[i] = o
+	}
+	src, err := recover.RecoverPartial(obs)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(src.Float64(mt.ClosedOpen) == server.Float64(mt.ClosedOpen))
+	//Output:
+	//true
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,115 @@
+package recover
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/goark/mt/v2"
+)
+
+// Observation is a partial observation of an output of Uint64 method of mt19937.Source:
+// the bits of Value in Mask are known, and the other bits are unknown.
+type Observation struct {
+	Value uint64
+	Mask  uint64
+}
+
+// Full returns Observation of a whole output y of Uint64 method.
+func Full(y uint64) Observation {
+	return Observation{Value: y, Mask: math.MaxUint64}
+}
+
+// Unknown returns Observation of an output which is consumed but not observed.
+func Unknown() Observation {
+	return Observation{}
+}
+
+// ObserveFloat64 returns Observation of an output f of Float64 method on the interval iv
+// (Real method with mode is the same as Float64 method with mt.ModeInterval(mode)).
+// The upper 53 bits (52 bits if iv is mt.Open) of the output of Uint64 method are known
+// (except when some outputs are rounded to the same f).
+func ObserveFloat64(f float64, iv mt.Interval) (Observation, error) {
+	shift, scale, offset := 11, 9007199254740992.0, 0.0
+	switch iv {
+	case mt.Closed:
+		scale = 9007199254740991.0
+	case mt.ClosedOpen:
+	case mt.OpenClosed:
+		offset = 1.0
+	default:
+		shift, scale, offset = 12, 4503599627370496.0, 0.5
+	}
+	if !(f >= 0 && f <= 1) {
+		return Observation{}, ErrInvalidValue
+	}
+	// different outputs may be mapped to f by rounding (for example, mt.Closed near 1)
+	k := int64(math.Round(f*scale - offset))
+	lo, hi := int64(-1), int64(-1)
+	for i := k - 4; i <= k+4; i++ {
+		if i < 0 || i >= 1<<(64-shift) || iv.Float64(uint64(i)<<shift) != f {
+			continue
+		}
+		if lo < 0 {
+			lo = i
+		}
+		hi = i
+	}
+	if lo < 0 {
+		return Observation{}, ErrInvalidValue
+	}
+	mask := uint64(math.MaxUint64) << (shift + bits.Len64(uint64(lo^hi)))
+	return Observation{Value: uint64(lo) << shift & mask, Mask: mask}, nil
+}
+
+// ObserveUint64N returns Observation of an output v of Uint64N(n) method of mt.PRNG (or mt.N function).
+// The known bits are the common upper bits of the outputs of Uint64 method mapped to v,
+// or the lower bits if n is a power of two.
+// It assumes that Uint64N method does not reject any output (its probability is less than n/2^64).
+func ObserveUint64N(v, n uint64) (Observation, error) {
+	if v >= n {
+		return Observation{}, ErrInvalidValue
+	}
+	if n&(n-1) == 0 {
+		return Observation{Value: v, Mask: n - 1}, nil
+	}
+	// the output y of Uint64 method satisfies v == floor(y*n/2^64), that is lo <= y <= hi
+	lo := ceilDiv(v, n)
+	hi := uint64(math.MaxUint64)
+	if v+1 < n {
+		hi = ceilDiv(v+1, n) - 1
+	}
+	mask := uint64(math.MaxUint64) << bits.Len64(lo^hi)
+	return Observation{Value: lo & mask, Mask: mask}, nil
+}
+
+// ceilDiv returns ceil(v*2^64/n) (v < n).
+func ceilDiv(v, n uint64) uint64 {
+	q, r := bits.Div64(v, 0, n)
+	if r != 0 {
+		q++
+	}
+	return q
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,117 @@
+package recover
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/goark/mt/v2"
+)
+
+func TestObserveFloat64(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(3, 4))
+	for _, tc := range []struct {
+		iv   mt.Interval
+		mask uint64
+	}{
+		{iv: mt.Closed, mask: 0xFFFFFFFFFFFFF800},
+		{iv: mt.ClosedOpen, mask: 0xFFFFFFFFFFFFF800},
+		{iv: mt.OpenClosed, mask: 0xFFFFFFFFFFFFF800},
+		{iv: mt.Open, mask: 0xFFFFFFFFFFFFF000},
+	} {
+		ys := []uint64{0, math.MaxUint64, 1 << 63, 1<<63 - 1}
+		for i := 0; i < 10000; i++ {
+			ys = append(ys, rnd.Uint64())
+		}
+		for _, y := range ys {
+			f := tc.iv.Float64(y)
+			o, err := ObserveFloat64(f, tc.iv)
+			if err != nil {
+				t.Errorf("ObserveFloat64(%v, %v) is \"%v\", want nil.", f, tc.iv, err)
+				continue
+			}
+			if o.Mask&tc.mask != o.Mask || bits.OnesCount64(o.Mask) < bits.OnesCount64(tc.mask)-1 || (o.Value^y)&o.Mask != 0 {
+				t.Errorf("ObserveFloat64(%v, %v) = %x/%x, want %x/%x.", f, tc.iv, o.Value, o.Mask, y&tc.mask, tc.mask)
+			}
+		}
+	}
+}
+
+func TestObserveFloat64Error(t *testing.T) {
+	for _, tc := range []struct {
+		f  float64
+		iv mt.Interval
+	}{
+		{f: -0.5, iv: mt.Closed},
+		{f: 1.5, iv: mt.Closed},
+		{f: math.NaN(), iv: mt.Closed},
+		{f: 0, iv: mt.Open},
+		{f: 1, iv: mt.ClosedOpen},
+		{f: 0x1p-60, iv: mt.ClosedOpen},
+	} {
+		if _, err := ObserveFloat64(tc.f, tc.iv); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("ObserveFloat64(%v, %v) is \"%v\", want \"%v\".", tc.f, tc.iv, err, ErrInvalidValue)
+		}
+	}
+}
+
+func TestObserveUint64N(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(5, 6))
+	for _, n := range []uint64{1, 2, 3, 6, 1 << 20, 1000000007, 1 << 63, 1<<63 + 1, math.MaxUint64} {
+		const count = 10000
+		known := 0
+		for i := 0; i < count; i++ {
+			y := rnd.Uint64()
+			v, _ := bits.Mul64(y, n)
+			if n&(n-1) == 0 {
+				v = y & (n - 1)
+			}
+			o, err := ObserveUint64N(v, n)
+			if err != nil {
+				t.Errorf("ObserveUint64N(%v, %v) is \"%v\", want nil.", v, n, err)
+				continue
+			}
+			if (o.Value^y)&o.Mask != 0 {
+				t.Errorf("ObserveUint64N(%v, %v) = %x/%x, inconsistent with %x.", v, n, o.Value, o.Mask, y)
+			}
+			known += bits.OnesCount64(o.Mask)
+		}
+		// about log2(n) bits are known on average
+		if want := bits.Len64(n) - 3; known < want*count {
+			t.Errorf("ObserveUint64N(v, %v): %v known bits on average, want %v at least.", n, float64(known)/count, want)
+		}
+	}
+}
+
+func TestObserveUint64NError(t *testing.T) {
+	for _, tc := range []struct{ v, n uint64 }{{v: 0, n: 0}, {v: 3, n: 3}, {v: 10, n: 1 << 3}} {
+		if _, err := ObserveUint64N(tc.v, tc.n); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("ObserveUint64N(%v, %v) is \"%v\", want \"%v\".", tc.v, tc.n, err, ErrInvalidValue)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,118 @@
+// Package recover rebuilds the state of MT19937-64 (mt19937.Source) from its output
+// and predicts its future and past random numbers.
+// It demonstrates why Mersenne Twister must never be used for secrets (tokens, keys, passwords and so on):
+// 312 consecutive outputs of Uint64 method are enough to predict all the others.
+package recover
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/goark/mt/v2/mt19937"
+)
+
+const (
+	nn = 312 //number of words of the state vector
+	mm = nn / 2
+
+	upperMask uint64 = 0xFFFFFFFF80000000 //Most significant 33 bits
+	lowerMask uint64 = 0x000000007FFFFFFF //Least significant 31 bits
+	matrixA   uint64 = 0xB5026F5AA96619E9 //last row of twist matrix A
+)
+
+var (
+	// ErrTooFewOutputs is returned when the outputs are not enough to determine the state.
+	ErrTooFewOutputs = errors.New("too few outputs to recover the state")
+	// ErrInconsistent is returned when the outputs are not generated by MT19937-64.
+	ErrInconsistent = errors.New("outputs are inconsistent with MT19937-64")
+	// ErrInvalidValue is returned when a value can not be an output of the generator.
+	ErrInvalidValue = errors.New("invalid value of random number")
+)
+
+// Untemper returns the state word from which Uint64 method of mt19937.Source generates y
+// (inverse of the tempering function).
+func Untemper(y uint64) uint64 {
+	y ^= y >> 43
+	y ^= (y << 37) & 0xFFF7EEE000000000
+	x := y
+	for i := 0; i < 4; i++ {
+		x = y ^ ((x << 17) & 0x71D67FFFEDA60000)
+	}
+	y = x
+	for i := 0; i < 3; i++ {
+		x = y ^ ((x >> 29) & 0x5555555555555555)
+	}
+	return x
+}
+
+// temper returns the tempered value of the state word x (same as Uint64 method of mt19937.Source).
+func temper(x uint64) uint64 {
+	x ^= (x >> 29) & 0x5555555555555555
+	x ^= (x << 17) & 0x71D67FFFEDA60000
+	x ^= (x << 37) & 0xFFF7EEE000000000
+	x ^= (x >> 43)
+	return x
+}
+
+// Recover returns mt19937.Source rebuilt from consecutive outputs of Uint64 method (at least 312 values).
+// The next call of Uint64 method of the returned Source generates the value following the last output,
+// and Prev method generates the outputs (and the preceding values) backwards.
+// Position of the returned Source is 0.
+func Recover(outputs []uint64) (*mt19937.Source, error) {
+	if len(outputs) < nn {
+		return nil, ErrTooFewOutputs
+	}
+	var words [nn]uint64
+	for i := range words {
+		words[i] = Untemper(outputs[i])
+	}
+	s, err := newSource(&words)
+	if err != nil {
+		return nil, err
+	}
+	for _, y := range outputs[nn:] {
+		if s.Uint64() != y {
+			return nil, ErrInconsistent
+		}
+	}
+	return s, nil
+}
+
+// newSource returns mt19937.Source which has generated the state words in order.
+func newSource(words *[nn]uint64) (*mt19937.Source, error) {
+	b := make([]byte, 0, (nn+1)*21)
+	for _, x := range words {
+		b = strconv.AppendUint(b, x, 10)
+		b = append(b, ' ')
+	}
+	b = strconv.AppendInt(b, nn, 10)
+	s := &mt19937.Source{}
+	if err := s.UnmarshalText(b); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInconsistent, err)
+	}
+	return s, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,108 @@
+package recover
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/goark/mt/v2/mt19937"
+)
+
+func TestUntemper(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < 10000; i++ {
+		x := rnd.Uint64()
+		if y := Untemper(temper(x)); y != x {
+			t.Errorf("Untemper(temper(%x)) = %x, want %x.", x, y, x)
+		}
+		if y := temper(Untemper(x)); y != x {
+			t.Errorf("temper(Untemper(%x)) = %x, want %x.", x, y, x)
+		}
+	}
+}
+
+// outputs returns n outputs of Uint64 method after skipping some outputs.
+func outputs(src *mt19937.Source, skip, n int) []uint64 {
+	src.Discard(uint64(skip))
+	res := make([]uint64, n)
+	for i := range res {
+		res[i] = src.Uint64()
+	}
+	return res
+}
+
+func TestRecover(t *testing.T) {
+	for _, tc := range []struct {
+		skip, n int
+	}{
+		{skip: 0, n: 312},
+		{skip: 1, n: 312},
+		{skip: 1000, n: 312},
+		{skip: 155, n: 500},
+		{skip: 12345, n: 1000},
+	} {
+		ref := mt19937.New(int64(tc.skip))
+		past := outputs(ref, 0, tc.skip)
+		obs := outputs(ref, 0, tc.n)
+		s, err := Recover(obs)
+		if err != nil {
+			t.Errorf("Recover() (skip %v, n %v) is \"%v\", want nil.", tc.skip, tc.n, err)
+			continue
+		}
+		// future
+		for i := 0; i < 1000; i++ {
+			if r, res := s.Uint64(), ref.Uint64(); r != res {
+				t.Errorf("Recover() (skip %v, n %v): %v-th value = %v, want %v.", tc.skip, tc.n, i, r, res)
+				break
+			}
+		}
+		// past
+		for i := 0; i < 1000; i++ {
+			s.Prev()
+		}
+		all := append(past, obs...)
+		for i := len(all) - 1; i >= 0; i-- {
+			if r := s.Prev(); r != all[i] {
+				t.Errorf("Recover() (skip %v, n %v): %v-th past value = %v, want %v.", tc.skip, tc.n, i, r, all[i])
+				break
+			}
+		}
+	}
+}
+
+func TestRecoverError(t *testing.T) {
+	obs := outputs(mt19937.New(19650218), 100, 400)
+	if _, err := Recover(obs[:311]); !errors.Is(err, ErrTooFewOutputs) {
+		t.Errorf("Recover() is \"%v\", want \"%v\".", err, ErrTooFewOutputs)
+	}
+	obs[350] ^= 1
+	if _, err := Recover(obs); !errors.Is(err, ErrInconsistent) {
+		t.Errorf("Recover() is \"%v\", want \"%v\".", err, ErrInconsistent)
+	}
+	if _, err := Recover(make([]uint64, 312)); !errors.Is(err, ErrInconsistent) {
+		t.Errorf("Recover() is \"%v\", want \"%v\".", err, ErrInconsistent)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,268 @@
+package recover
+
+import (
+	"math/bits"
+
+	"github.com/goark/mt/v2/mt19937"
+)
+
+// RecoverPartial returns mt19937.Source rebuilt from partial observations of consecutive outputs
+// of Uint64 method (see Observation type), solving a system of linear equations over GF(2).
+// It needs at least 312 observations, and usually about 624 observations (two state vectors)
+// if some bits are unknown; the more unknown bits, the more time and memory it takes.
+// The returned Source is the same as the one of Recover function.
+func RecoverPartial(obs []Observation) (*mt19937.Source, error) {
+	if len(obs) < nn {
+		return nil, ErrTooFewOutputs
+	}
+	// unknowns are the unknown bits of the first nn outputs (before untempering)
+	var (
+		base  [nn]uint64 //state words with the unknown bits cleared
+		first [nn]int    //index of the first unknown of each word
+	)
+	n := 0
+	for i := range base {
+		base[i] = Untemper(obs[i].Value & obs[i].Mask)
+		first[i] = n
+		n += bits.OnesCount64(^obs[i].Mask)
+	}
+	sys := newSystem(n)
+	if sys.rank < n {
+		if err := sys.eliminate(obs, &base); err != nil {
+			return nil, err
+		}
+	}
+	// free unknowns are allowed only in the lower bits of the first word, which never affect the output
+	if sys.n-sys.rank > 31 {
+		return nil, ErrTooFewOutputs
+	}
+	for _, d := range sys.kernel() {
+		for i := range base {
+			x := unknownWord(d, obs[i].Mask, first[i])
+			if i == 0 {
+				x &= upperMask
+			}
+			if x != 0 {
+				return nil, ErrTooFewOutputs
+			}
+		}
+	}
+	z := sys.solve()
+	var words [nn]uint64
+	for i := range words {
+		words[i] = base[i] ^ unknownWord(z, obs[i].Mask, first[i])
+	}
+	s, err := newSource(&words)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range obs[nn:] {
+		if (s.Uint64()^o.Value)&o.Mask != 0 {
+			return nil, ErrInconsistent
+		}
+	}
+	return s, nil
+}
+
+// unknownWord returns the state word (before untempering) which consists of the unknown bits in z
+// (the bits out of mask of the output, from index k of z).
+func unknownWord(z []uint64, mask uint64, k int) uint64 {
+	var x uint64
+	for c := 0; c < 64; c++ {
+		if mask&(1<<c) != 0 {
+			continue
+		}
+		if z[k/64]&(1<<(k%64)) != 0 {
+			x ^= Untemper(1 << c)
+		}
+		k++
+	}
+	return x
+}
+
+// system is a system of linear equations over GF(2) in row echelon form.
+// A row is a bit vector of n unknowns and the constant term (bit n).
+type system struct {
+	n      int
+	size   int        //number of words of a row
+	pivots [][]uint64 //pivots[c] is the row whose first unknown is c (or nil)
+	rank   int
+}
+
+func newSystem(n int) *system {
+	return &system{n: n, size: n/64 + 1, pivots: make([][]uint64, n)}
+}
+
+// newRow returns a zero row.
+func (sys *system) newRow() []uint64 {
+	return make([]uint64, sys.size)
+}
+
+// add adds the equation row (sum of unknowns == constant term) to the system.
+func (sys *system) add(row []uint64) error {
+	for w := 0; w < sys.size; {
+		x := row[w]
+		if w == sys.n/64 {
+			x &= 1<<(sys.n%64) - 1
+		}
+		if x == 0 {
+			w++
+			continue
+		}
+		c := w*64 + bits.TrailingZeros64(x)
+		p := sys.pivots[c]
+		if p == nil {
+			sys.pivots[c] = row
+			sys.rank++
+			return nil
+		}
+		for i := w; i < sys.size; i++ {
+			row[i] ^= p[i]
+		}
+	}
+	if row[sys.n/64]&(1<<(sys.n%64)) != 0 {
+		return ErrInconsistent
+	}
+	return nil
+}
+
+// eliminate adds the equations of obs[nn:] until the rank is full.
+func (sys *system) eliminate(obs []Observation, base *[nn]uint64) error {
+	// tempering and untempering are linear: tempered[b] is the set of bits of x summed to bit b of temper(x)
+	var tempered [64]uint64
+	for c := 0; c < 64; c++ {
+		for y := temper(1 << c); y != 0; y &= y - 1 {
+			tempered[bits.TrailingZeros64(y)] |= 1 << c
+		}
+	}
+	// symbolic state words (bit b of a word is an affine function of the unknowns)
+	ring := make([][64][]uint64, nn)
+	k := 0
+	for i := range ring {
+		for b := range ring[i] {
+			row := sys.newRow()
+			if base[i]&(1<<b) != 0 {
+				row[sys.n/64] |= 1 << (sys.n % 64)
+			}
+			ring[i][b] = row
+		}
+		for c := 0; c < 64; c++ {
+			if obs[i].Mask&(1<<c) != 0 {
+				continue
+			}
+			for x := Untemper(1 << c); x != 0; x &= x - 1 {
+				ring[i][bits.TrailingZeros64(x)][k/64] ^= 1 << (k % 64)
+			}
+			k++
+		}
+	}
+	var next [64][]uint64
+	for b := range next {
+		next[b] = sys.newRow()
+	}
+	for j := nn; j < len(obs) && sys.rank < sys.n; j++ {
+		// x[j] = x[j-mm] ^ A(upper bits of x[j-nn] | lower bits of x[j-nn+1])
+		prev, prev1, mid := &ring[j%nn], &ring[(j+1)%nn], &ring[(j+mm)%nn]
+		y := func(b int) []uint64 {
+			if lowerMask&(1<<b) != 0 {
+				return prev1[b]
+			}
+			return prev[b]
+		}
+		for b := range next {
+			copy(next[b], mid[b])
+			if b < 63 {
+				xorRow(next[b], y(b+1))
+			}
+			if matrixA&(1<<b) != 0 {
+				xorRow(next[b], y(0))
+			}
+		}
+		for b := range next {
+			prev[b], next[b] = next[b], prev[b]
+		}
+		for m := obs[j].Mask; m != 0; m &= m - 1 {
+			b := bits.TrailingZeros64(m)
+			row := sys.newRow()
+			for t := tempered[b]; t != 0; t &= t - 1 {
+				xorRow(row, prev[bits.TrailingZeros64(t)])
+			}
+			if obs[j].Value&(1<<b) != 0 {
+				row[sys.n/64] ^= 1 << (sys.n % 64)
+			}
+			if err := sys.add(row); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// solve returns a solution of the system (free unknowns are 0).
+func (sys *system) solve() []uint64 {
+	z := sys.newRow()
+	for c := sys.n - 1; c >= 0; c-- {
+		if p := sys.pivots[c]; p != nil && (dot(p, z)^p[sys.n/64]>>(sys.n%64))&1 != 0 {
+			z[c/64] |= 1 << (c % 64)
+		}
+	}
+	return z
+}
+
+// kernel returns a basis of the solutions of the homogeneous system.
+func (sys *system) kernel() [][]uint64 {
+	var ker [][]uint64
+	for f := 0; f < sys.n; f++ {
+		if sys.pivots[f] != nil {
+			continue
+		}
+		d := sys.newRow()
+		d[f/64] |= 1 << (f % 64)
+		for c := f - 1; c >= 0; c-- {
+			if p := sys.pivots[c]; p != nil && dot(p, d)&1 != 0 {
+				d[c/64] |= 1 << (c % 64)
+			}
+		}
+		ker = append(ker, d)
+	}
+	return ker
+}
+
+// xorRow sets a = a ^ b.
+func xorRow(a, b []uint64) {
+	for i := range a {
+		a[i] ^= b[i]
+	}
+}
+
+// dot returns the inner product of a and b (the constant term of b must be 0).
+func dot(a, b []uint64) uint64 {
+	var x uint64
+	for i := range a {
+		x ^= a[i] & b[i]
+	}
+	return uint64(bits.OnesCount64(x) & 1)
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
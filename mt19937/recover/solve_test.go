@@ -0,0 +1,131 @@
+package recover
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/goark/mt/v2"
+	"github.com/goark/mt/v2/mt19937"
+)
+
+// checkSource checks that s generates the same values as ref in both directions.
+func checkSource(t *testing.T, name string, s *mt19937.Source, ref *mt19937.Source, past int) {
+	t.Helper()
+	for i := 0; i < 1000; i++ {
+		if r, res := s.Uint64(), ref.Uint64(); r != res {
+			t.Errorf("%v: %v-th value = %v, want %v.", name, i, r, res)
+			return
+		}
+	}
+	for i := 0; i < 1000+past; i++ {
+		if r, res := s.Prev(), ref.Prev(); r != res {
+			t.Errorf("%v: %v-th past value = %v, want %v.", name, i, r, res)
+			return
+		}
+	}
+}
+
+func TestRecoverPartial(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		n       int
+		observe func(prng *mt.PRNG) (Observation, error)
+	}{
+		{name: "Uint64", n: 312, observe: func(prng *mt.PRNG) (Observation, error) { return Full(prng.Uint64()), nil }},
+		{name: "Float64", n: 650, observe: func(prng *mt.PRNG) (Observation, error) {
+			return ObserveFloat64(prng.Float64(mt.ClosedOpen), mt.ClosedOpen)
+		}},
+		{name: "Real", n: 650, observe: func(prng *mt.PRNG) (Observation, error) {
+			return ObserveFloat64(prng.Real(1), mt.ModeInterval(1))
+		}},
+		{name: "Uint64N(2^48)", n: 650, observe: func(prng *mt.PRNG) (Observation, error) {
+			return ObserveUint64N(prng.Uint64N(1<<48), 1<<48)
+		}},
+		{name: "Uint64N(10^15)", n: 650, observe: func(prng *mt.PRNG) (Observation, error) {
+			return ObserveUint64N(prng.Uint64N(1000000000000000), 1000000000000000)
+		}},
+	} {
+		ref := mt19937.New(19650218)
+		ref.Discard(1000)
+		prng := mt.New(ref)
+		obs := make([]Observation, tc.n)
+		for i := range obs {
+			o, err := tc.observe(prng)
+			if err != nil {
+				t.Fatalf("%v: observation is \"%v\", want nil.", tc.name, err)
+			}
+			obs[i] = o
+		}
+		s, err := RecoverPartial(obs)
+		if err != nil {
+			t.Errorf("RecoverPartial() (%v) is \"%v\", want nil.", tc.name, err)
+			continue
+		}
+		checkSource(t, "RecoverPartial() ("+tc.name+")", s, ref, tc.n+100)
+	}
+}
+
+func TestRecoverPartialUnknown(t *testing.T) {
+	// some outputs are consumed by others
+	ref := mt19937.New(12345)
+	obs := make([]Observation, 1000)
+	for i := range obs {
+		y := ref.Uint64()
+		if i%5 == 2 {
+			obs[i] = Unknown()
+		} else {
+			obs[i], _ = ObserveFloat64(mt.Open.Float64(y), mt.Open)
+		}
+	}
+	s, err := RecoverPartial(obs)
+	if err != nil {
+		t.Fatalf("RecoverPartial() is \"%v\", want nil.", err)
+	}
+	checkSource(t, "RecoverPartial() (with unknown outputs)", s, ref, 1000)
+}
+
+func TestRecoverPartialError(t *testing.T) {
+	ref := mt19937.New(19650218)
+	obs := make([]Observation, 700)
+	for i := range obs {
+		obs[i], _ = ObserveFloat64(mt.ClosedOpen.Float64(ref.Uint64()), mt.ClosedOpen)
+	}
+	if _, err := RecoverPartial(obs[:311]); !errors.Is(err, ErrTooFewOutputs) {
+		t.Errorf("RecoverPartial() is \"%v\", want \"%v\".", err, ErrTooFewOutputs)
+	}
+	if _, err := RecoverPartial(obs[:600]); !errors.Is(err, ErrTooFewOutputs) {
+		t.Errorf("RecoverPartial() is \"%v\", want \"%v\".", err, ErrTooFewOutputs)
+	}
+	obs[350].Value ^= 1 << 63
+	if _, err := RecoverPartial(obs); !errors.Is(err, ErrInconsistent) {
+		t.Errorf("RecoverPartial() is \"%v\", want \"%v\".", err, ErrInconsistent)
+	}
+	obs[350].Value ^= 1 << 63
+	obs[690].Value ^= 1 << 63 // after the rank is full
+	if _, err := RecoverPartial(obs); !errors.Is(err, ErrInconsistent) {
+		t.Errorf("RecoverPartial() is \"%v\", want \"%v\".", err, ErrInconsistent)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,48 @@
+package mt19937
+
+import (
+	"runtime"
+
+	"github.com/goark/mt/v2"
+)
+
+// NewSharded returns new mt.ShardedPRNG instance with shards sources derived from seed.
+// The i-th shard is the source seeded with the given value and advanced by Jump method i times,
+// so that shards generate non-overlapping sequences.
+// If shards <= 0, runtime.GOMAXPROCS(0) is used.
+func NewSharded(seed int64, shards int) *mt.ShardedPRNG {
+	if shards <= 0 {
+		shards = runtime.GOMAXPROCS(0)
+	}
+	src := New(seed)
+	sources := make([]mt.Source, shards)
+	for i := range sources {
+		s := *src
+		sources[i] = &s
+		src.Jump()
+	}
+	return mt.NewSharded(sources...)
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
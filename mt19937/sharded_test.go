@@ -0,0 +1,76 @@
+package mt19937
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestNewSharded(t *testing.T) {
+	const shards = 3
+	prng := NewSharded(19650218, shards)
+	if n := prng.Shards(); n != shards {
+		t.Errorf("ShardedPRNG.Shards() = %v, want %v.", n, shards)
+	}
+	// expected sequence of each shard
+	expected := make([]*Source, shards)
+	src := New(19650218)
+	for i := range expected {
+		s := *src
+		expected[i] = &s
+		src.Jump()
+	}
+	next := make([]uint64, shards)
+	for i, s := range expected {
+		next[i] = s.Uint64()
+	}
+	for i := 0; i < 10000; i++ {
+		r := prng.Uint64()
+		found := false
+		for j := range next {
+			if r == next[j] {
+				next[j] = expected[j].Uint64()
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("ShardedPRNG.Uint64() = %v, not in any shard.", r)
+			break
+		}
+	}
+
+	if n := NewSharded(19650218, 0).Shards(); n != runtime.GOMAXPROCS(0) {
+		t.Errorf("ShardedPRNG.Shards() = %v, want %v.", n, runtime.GOMAXPROCS(0))
+	}
+	single := NewSharded(19650218, 1)
+	rnd := New(19650218)
+	for i := 0; i < 1000; i++ {
+		if r, res := single.Uint64(), rnd.Uint64(); r != res {
+			t.Errorf("ShardedPRNG.Uint64() = %v, want %v.", r, res)
+			break
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,42 @@
+// Generator of golden files for std::mt19937_64 text-stream state (libstdc++).
+//
+//   g++ -std=c++11 -O2 -o stdmt19937_64 stdmt19937_64.cpp && ./stdmt19937_64
+//
+// For each case, it writes the state (operator<<) to <name>.state.txt
+// and the next 1000 outputs to <name>.out.txt.
+#include <fstream>
+#include <random>
+#include <string>
+
+static void dump(const std::string &name, std::mt19937_64 &e) {
+    std::ofstream st(name + ".state.txt");
+    st << e << std::endl;
+    std::ofstream out(name + ".out.txt");
+    for (int i = 0; i < 1000; i++) {
+        out << e() << std::endl;
+    }
+}
+
+int main() {
+    {
+        std::mt19937_64 e;
+        dump("default", e);
+    }
+    {
+        std::mt19937_64 e(19650218);
+        e.discard(100);
+        dump("seed19650218_discard100", e);
+    }
+    {
+        std::mt19937_64 e(19650218);
+        e.discard(312);
+        dump("seed19650218_discard312", e);
+    }
+    {
+        std::seed_seq seq{0x12345, 0x23456, 0x34567, 0x45678};
+        std::mt19937_64 e(seq);
+        e.discard(1000);
+        dump("seedseq_discard1000", e);
+    }
+    return 0;
+}
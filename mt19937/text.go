@@ -0,0 +1,94 @@
+package mt19937
+
+import (
+	"bytes"
+	"encoding"
+	"fmt"
+	"strconv"
+)
+
+var (
+	_ encoding.TextMarshaler   = (*Source)(nil) //Source is compatible with encoding.TextMarshaler interface
+	_ encoding.TextUnmarshaler = (*Source)(nil) //Source is compatible with encoding.TextUnmarshaler interface
+)
+
+// AppendText appends the textual encoding of the state of Source to b.
+// The format is the same as std::mt19937_64 in C++ (libstdc++) writes with operator<<:
+// 312 words of the state vector and the index (mti), as space-separated decimal numbers.
+func (s *Source) AppendText(b []byte) ([]byte, error) {
+	if s == nil {
+		return b, fmt.Errorf("%w: nil source", ErrInvalidState)
+	}
+	if s.mti >= nn+1 {
+		return New(5489).AppendText(b) // a default initial seed is used (same as std::mt19937_64 default constructor)
+	}
+	for _, x := range s.mt {
+		b = strconv.AppendUint(b, x, 10)
+		b = append(b, ' ')
+	}
+	return strconv.AppendInt(b, int64(s.mti), 10), nil
+}
+
+// MarshalText returns the textual encoding of the state of Source
+// (compatible with encoding.TextMarshaler interface).
+// The state can be restored by operator>> of std::mt19937_64 in C++ (libstdc++).
+func (s *Source) MarshalText() ([]byte, error) {
+	return s.AppendText(make([]byte, 0, (nn+1)*21))
+}
+
+// UnmarshalText restores the state of Source from the textual encoding
+// (compatible with encoding.TextUnmarshaler interface).
+// It accepts the state of std::mt19937_64 in C++ (libstdc++) written by operator<<.
+// Position of Source is reset to 0 (it is not included in the encoding).
+func (s *Source) UnmarshalText(text []byte) error {
+	if s == nil {
+		return fmt.Errorf("%w: nil source", ErrInvalidState)
+	}
+	fields := bytes.Fields(text)
+	if len(fields) != nn+1 {
+		return fmt.Errorf("%w: %d words (want %d)", ErrInvalidState, len(fields), nn+1)
+	}
+	var mt [nn]uint64
+	for i := range mt {
+		x, err := strconv.ParseUint(string(fields[i]), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidState, err)
+		}
+		mt[i] = x
+	}
+	mti, err := strconv.ParseUint(string(fields[nn]), 10, 16)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidState, err)
+	}
+	if mti > nn {
+		return fmt.Errorf("%w: index %d is out of range", ErrInvalidState, mti)
+	}
+	if isZeroState(&mt) {
+		return fmt.Errorf("%w: all-zero state vector", ErrInvalidState)
+	}
+	s.mt, s.mti, s.pos = mt, int(mti), 0
+	return nil
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
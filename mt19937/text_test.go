@@ -0,0 +1,155 @@
+package mt19937
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func readGolden(t *testing.T, name string) ([]byte, []uint64) {
+	t.Helper()
+	state, err := os.ReadFile(filepath.Join("testdata", name+".state.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(filepath.Join("testdata", name+".out.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	outs := []uint64{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		n, err := strconv.ParseUint(scanner.Text(), 10, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		outs = append(outs, n)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return state, outs
+}
+
+func TestUnmarshalTextStd(t *testing.T) {
+	testCases := []string{
+		"default",
+		"seed19650218_discard100",
+		"seed19650218_discard312",
+		"seedseq_discard1000",
+	}
+	for _, name := range testCases {
+		state, outs := readGolden(t, name)
+		rnd := New(0)
+		if err := rnd.UnmarshalText(state); err != nil {
+			t.Errorf("Source.UnmarshalText(%s) is \"%v\", want nil.", name, err)
+			continue
+		}
+		text, err := rnd.MarshalText()
+		if err != nil {
+			t.Errorf("Source.MarshalText(%s) is \"%v\", want nil.", name, err)
+		}
+		if !bytes.Equal(text, bytes.TrimSpace(state)) {
+			t.Errorf("Source.MarshalText(%s) = \"%s\", want \"%s\".", name, text, state)
+		}
+		for i, res := range outs {
+			if r := rnd.Uint64(); r != res {
+				t.Errorf("Source.Uint64() (%s): %v-th value = %v, want %v.", name, i, r, res)
+				break
+			}
+		}
+	}
+}
+
+func TestMarshalTextStd(t *testing.T) {
+	testCases := []struct {
+		name string
+		rnd  *Source
+		skip int
+	}{
+		{name: "default", rnd: &Source{mt: [nn]uint64{}, mti: nn + 1}, skip: 0},
+		{name: "seed19650218_discard100", rnd: New(19650218), skip: 100},
+		{name: "seed19650218_discard312", rnd: New(19650218), skip: 312},
+	}
+	for _, tc := range testCases {
+		state, _ := readGolden(t, tc.name)
+		for i := 0; i < tc.skip; i++ {
+			_ = tc.rnd.Uint64()
+		}
+		text, err := tc.rnd.MarshalText()
+		if err != nil {
+			t.Errorf("Source.MarshalText(%s) is \"%v\", want nil.", tc.name, err)
+		}
+		if !bytes.Equal(text, bytes.TrimSpace(state)) {
+			t.Errorf("Source.MarshalText(%s) = \"%s\", want \"%s\".", tc.name, text, state)
+		}
+	}
+}
+
+func TestUnmarshalTextErr(t *testing.T) {
+	valid, _ := New(19650218).MarshalText()
+	fields := bytes.Fields(valid)
+	join := func(f ...[]byte) []byte {
+		return bytes.Join(f, []byte(" "))
+	}
+	zero := make([][]byte, nn+1)
+	for i := range zero {
+		zero[i] = []byte("0")
+	}
+	testCases := []struct {
+		name string
+		text []byte
+	}{
+		{name: "empty", text: nil},
+		{name: "short", text: join(fields[:nn]...)},
+		{name: "long", text: join(append(fields[:nn+1:nn+1], []byte("0"))...)},
+		{name: "not number", text: join(append(fields[:nn:nn], []byte("x"))...)},
+		{name: "overflow", text: join(append([][]byte{[]byte("18446744073709551616")}, fields[1:]...)...)},
+		{name: "index", text: join(append(fields[:nn:nn], []byte("313"))...)},
+		{name: "negative index", text: join(append(fields[:nn:nn], []byte("-1"))...)},
+		{name: "zero", text: join(zero...)},
+	}
+	for _, tc := range testCases {
+		rnd := New(5489)
+		if err := rnd.UnmarshalText(tc.text); !errors.Is(err, ErrInvalidState) {
+			t.Errorf("Source.UnmarshalText(%s) is \"%v\", want \"%v\".", tc.name, err, ErrInvalidState)
+		}
+		if r, res := rnd.Uint64(), New(5489).Uint64(); r != res {
+			t.Errorf("Source.Uint64() after error = %v, want %v.", r, res)
+		}
+	}
+	if _, err := (*Source)(nil).MarshalText(); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("<nil>.MarshalText() is \"%v\", want \"%v\".", err, ErrInvalidState)
+	}
+	if err := (*Source)(nil).UnmarshalText(valid); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("<nil>.UnmarshalText() is \"%v\", want \"%v\".", err, ErrInvalidState)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,200 @@
+package mt19937ar
+
+import (
+	"github.com/goark/mt/v2"
+)
+
+const (
+	nn = 624
+	mm = 397
+)
+
+// Source is a source of random numbers (32-bit MT19937).
+type Source struct {
+	mt  [nn]uint32 //The array for the state vector
+	mti int        //mti==nn+1 means mt[nn] is not initialized
+}
+
+var _ mt.Source = (*Source)(nil) //Source is compatible with mt.Source interface
+var _ mt.Seeder = (*Source)(nil) //Source is compatible with mt.Seeder interface
+
+// New returns a new pseudo-random source seeded with the given value.
+// Only the lower 32 bits of seed are used (same as init_genrand function).
+func New(seed int64) *Source {
+	rng := &Source{mt: [nn]uint32{}, mti: nn + 1}
+	rng.Seed(seed)
+	return rng
+}
+
+// NewWithArray returns a new pseudo-random source seeded with the given values.
+// Only the lower 32 bits of each seed are used (same as init_by_array function).
+func NewWithArray(seeds []uint64) *Source {
+	rng := &Source{mt: [nn]uint32{}, mti: nn + 1}
+	rng.SeedArray(seeds)
+	return rng
+}
+
+// Seed initializes Source with a seed
+func (s *Source) Seed(seed int64) {
+	if s == nil {
+		return
+	}
+	s.mt[0] = uint32(seed)
+	for s.mti = 1; s.mti < nn; s.mti++ {
+		s.mt[s.mti] = 1812433253*(s.mt[s.mti-1]^(s.mt[s.mti-1]>>30)) + uint32(s.mti)
+	}
+}
+
+// SeedArray initializes Source with seeds array
+func (s *Source) SeedArray(seeds []uint64) {
+	if s == nil {
+		return
+	}
+	s.Seed(19650218)
+	k := len(seeds)
+	if k == 0 {
+		return
+	}
+	if nn > k {
+		k = nn
+	}
+	i := 1
+	j := 0
+	for ; k > 0; k-- {
+		s.mt[i] = (s.mt[i] ^ ((s.mt[i-1] ^ (s.mt[i-1] >> 30)) * 1664525)) + uint32(seeds[j]) + uint32(j) // non linear
+		i++
+		if i >= nn {
+			s.mt[0] = s.mt[nn-1]
+			i = 1
+		}
+		j++
+		if j >= len(seeds) {
+			j = 0
+		}
+	}
+	for k = nn - 1; k > 0; k-- {
+		s.mt[i] = (s.mt[i] ^ ((s.mt[i-1] ^ (s.mt[i-1] >> 30)) * 1566083941)) - uint32(i) // non linear
+		i++
+		if i >= nn {
+			s.mt[0] = s.mt[nn-1]
+			i = 1
+		}
+	}
+	s.mt[0] = 1 << 31 //MSB is 1; assuring non-zero initial array
+}
+
+const (
+	upperMask = 0x80000000 //Most significant w-r bits
+	lowerMask = 0x7FFFFFFF //Least significant r bits
+)
+
+var (
+	matrixA = [2]uint32{0, 0x9908B0DF}
+)
+
+// Uint32 generates a random number on [0, 2^32-1]-interval
+func (s *Source) Uint32() uint32 {
+	if s == nil {
+		return 0
+	}
+	if s.mti >= nn {
+		if s.mti >= 1+nn {
+			s.Seed(5489) // a default initial seed is used
+		}
+		for i := 0; i < nn-1; i++ {
+			y := (s.mt[i] & upperMask) | (s.mt[i+1] & lowerMask)
+			if i < (nn - mm) {
+				s.mt[i] = s.mt[i+mm] ^ (y >> 1) ^ matrixA[(int)(y&0x01)]
+			} else {
+				s.mt[i] = s.mt[i+(mm-nn)] ^ (y >> 1) ^ matrixA[(int)(y&0x01)]
+			}
+		}
+		y := (s.mt[nn-1] & upperMask) | (s.mt[0] & lowerMask)
+		s.mt[nn-1] = s.mt[mm-1] ^ (y >> 1) ^ matrixA[(int)(y&0x01)]
+		s.mti = 0
+	}
+
+	y := s.mt[s.mti]
+	s.mti++
+	y ^= (y >> 11)
+	y ^= (y << 7) & 0x9D2C5680
+	y ^= (y << 15) & 0xEFC60000
+	y ^= (y >> 18)
+	return y
+}
+
+// Uint64 generates a random number on [0, 2^64-1]-interval.
+// It is composed of two Uint32 values; the first one is the upper 32 bits.
+func (s *Source) Uint64() uint64 {
+	if s == nil {
+		return 0
+	}
+	hi := uint64(s.Uint32())
+	return hi<<32 | uint64(s.Uint32())
+}
+
+// Real generates a random number
+// on [0,1]-real-interval if mode==1 (genrand_real1 function),
+// on [0,1)-real-interval if mode==2 (genrand_real2 function),
+// on (0,1)-real-interval others (genrand_real3 function)
+//
+// Deprecated: use Source.Float64 method instead.
+// Unlike other packages, Real is not the same as Float64(mt.ModeInterval(mode)):
+// it keeps 32-bit resolution of the reference genrand_real functions for compatibility.
+func (s *Source) Real(mode int) float64 {
+	if s == nil {
+		return 0.0
+	}
+	switch mode {
+	case 1: //generates a random number on [0,1]-real-interval
+		return (float64)(s.Uint32()) * (1.0 / 4294967295.0)
+	case 2: //generates a random number on [0,1)-real-interval
+		return (float64)(s.Uint32()) * (1.0 / 4294967296.0)
+	default: //generates a random number on (0,1)-real-interval
+		return ((float64)(s.Uint32()) + 0.5) * (1.0 / 4294967296.0)
+	}
+}
+
+// Float64 generates a random number on the interval iv with 53-bit resolution (52-bit if iv is mt.Open).
+// It uses two Uint32 values (see Uint64 method).
+func (s *Source) Float64(iv mt.Interval) float64 {
+	if s == nil {
+		return 0.0
+	}
+	return iv.Float64(s.Uint64())
+}
+
+// Res53 generates a random number on [0,1)-real-interval with 53-bit resolution
+// (genrand_res53 function).
+func (s *Source) Res53() float64 {
+	if s == nil {
+		return 0.0
+	}
+	a := s.Uint32() >> 5
+	b := s.Uint32() >> 6
+	return ((float64)(a)*67108864.0 + (float64)(b)) * (1.0 / 9007199254740992.0)
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel, fork from Mersenne Twister code "mt19937ar.c".
+ * (http://www.math.sci.hiroshima-u.ac.jp/m-mat/MT/MT2002/emt19937ar.html)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -2,13 +2,22 @@ package mt
 
 import (
 	"math/rand/v2"
+	"slices"
 	"sync"
+	"sync/atomic"
 )
 
 // Source represents a source of uniformly-distributed
+// (it may also implement Seeder interface as an optional extension)
 type Source interface {
 	rand.Source
 	SeedArray([]uint64)
+	// Real generates a random number
+	// on [0,1]-real-interval if mode==1,
+	// on [0,1)-real-interval if mode==2,
+	// on (0,1)-real-interval others.
+	//
+	// Deprecated: use Interval.Float64 method (or Float64 method of PRNG) instead.
 	Real(int) float64
 }
 
@@ -16,9 +25,12 @@ type Source interface {
 type PRNG struct {
 	source Source
 	mutex  *sync.Mutex
+	seed   func(s Source) //the last seeding (for Reset method)
+	gen    atomic.Uint64  //generation of the source; incremented by seeding or replacing the source
 }
 
 var _ rand.Source = (*PRNG)(nil) //PRNG is compatible with rand.Source and rand.Source64 interface
+var _ Source = (*PRNG)(nil)      //PRNG is compatible with Source interface
 
 // New returns new PRNG instance
 func New(s Source) *PRNG {
@@ -30,9 +42,8 @@ func (prng *PRNG) SeedArray(seeds []uint64) {
 	if prng == nil {
 		return
 	}
-	prng.mutex.Lock()
-	prng.source.SeedArray(seeds)
-	prng.mutex.Unlock()
+	seeds = slices.Clone(seeds)
+	prng.reseed(func(s Source) { s.SeedArray(seeds) })
 }
 
 // Uint64 generates a random number on [0, 2^64-1]-interval
@@ -58,9 +69,11 @@ func (prng *PRNG) Uint64() (n uint64) {
 // }
 
 // Real generates a random number
-// on [0,1)-real-interval if mode==1,
-// on (0,1)-real-interval if mode==2,
-// on [0,1]-real-interval others
+// on [0,1]-real-interval if mode==1,
+// on [0,1)-real-interval if mode==2,
+// on (0,1)-real-interval others
+//
+// Deprecated: use PRNG.Float64 method instead.
 func (prng *PRNG) Real(mode int) (f float64) {
 	if prng == nil {
 		return 0
@@ -71,9 +84,35 @@ func (prng *PRNG) Real(mode int) (f float64) {
 	return
 }
 
-// NewReader returns new Reader instance.
+// Float64 generates a random number on the interval iv.
+// If the source has Float64(Interval) method (e.g. *dsfmt.Source), it is used.
+func (prng *PRNG) Float64(iv Interval) (f float64) {
+	if prng == nil {
+		return 0
+	}
+	prng.mutex.Lock()
+	f = float64Of(prng.source, iv)
+	prng.mutex.Unlock()
+	return
+}
+
+// SetSource replaces the source with s atomically under the lock, and returns the old source.
+// The last seed for Reset method is cleared.
+// If s is nil, the source is not replaced and nil is returned.
+func (prng *PRNG) SetSource(s Source) (old Source) {
+	if prng == nil || s == nil {
+		return nil
+	}
+	prng.mutex.Lock()
+	old, prng.source, prng.seed = prng.source, s, nil
+	prng.gen.Add(1)
+	prng.mutex.Unlock()
+	return
+}
+
+// NewReader returns new Reader instance with buffer of DefaultReaderSize bytes.
 func (prng *PRNG) NewReader() *Reader {
-	return &Reader{prng: prng}
+	return prng.NewReaderSize(DefaultReaderSize)
 }
 
 /* MIT License
@@ -3,14 +3,37 @@ package mt
 import (
 	"encoding/binary"
 	"errors"
-	"io"
 	"sync"
 	"testing"
 )
 
+// mockup for test (SplitMix64)
+type splitMix struct {
+	x uint64
+}
+
+func (s *splitMix) SeedArray(seeds []uint64) {
+	s.x = 0
+	for _, seed := range seeds {
+		s.x ^= seed
+	}
+}
+
+func (s *splitMix) Uint64() uint64 {
+	s.x += 0x9e3779b97f4a7c15
+	z := s.x
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+func (s *splitMix) Real(mode int) float64 { return ModeInterval(mode).Float64(s.Uint64()) }
+
 func TestNil(t *testing.T) {
 	prng := (*PRNG)(nil)
-	// prng.Seed(0)
+	prng.Seed(0)
+	prng.SeedBytes(nil)
+	prng.Reset()
 	prng.SeedArray(nil)
 	if prng.Uint64() != 0 {
 		t.Errorf("PRNG.Uint64() = %v, want %v.", prng.Uint64(), 0)
@@ -61,7 +84,8 @@ func getBytes(prng *PRNG) (uint64, error) {
 func TestReader(t *testing.T) {
 	prng := New(&testSource{})
 	wg := sync.WaitGroup{}
-	res := binary.LittleEndian.Uint64([]byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x40, 0xe2, 0x01, 0x00})
+	// continuous byte stream of 123456 (0x01e240) in little endian
+	res := binary.LittleEndian.Uint64([]byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0xe2, 0x01})
 	for i := 0; i < 1000; i++ {
 		wg.Add(1)
 		go func(id int) {
@@ -82,8 +106,8 @@ func TestReaderNil(t *testing.T) {
 	r := (*PRNG)(nil).NewReader()
 	buf := [8]byte{}
 	_, err := r.Read(buf[:])
-	if !errors.Is(err, io.ErrUnexpectedEOF) {
-		t.Errorf("PRNG.Read() is \"%v\", want \"%v\".", err, io.ErrUnexpectedEOF)
+	if !errors.Is(err, ErrNilReader) {
+		t.Errorf("PRNG.Read() is \"%v\", want \"%v\".", err, ErrNilReader)
 	}
 }
 
@@ -91,8 +115,8 @@ func TestNilReader(t *testing.T) {
 	r := (*Reader)(nil)
 	buf := [8]byte{}
 	_, err := r.Read(buf[:])
-	if !errors.Is(err, io.ErrUnexpectedEOF) {
-		t.Errorf("PRNG.Read() is \"%v\", want \"%v\".", err, io.ErrUnexpectedEOF)
+	if !errors.Is(err, ErrNilReader) {
+		t.Errorf("PRNG.Read() is \"%v\", want \"%v\".", err, ErrNilReader)
 	}
 }
 
@@ -1,84 +1,241 @@
 package mt
 
 import (
+	"errors"
 	"io"
+	"sync"
+	"sync/atomic"
 )
 
-//Reader is class of pseudo random number generator with io.Reader interface.
+// DefaultReaderSize is the default size of buffer in Reader (312 words; a regeneration of MT19937-64).
+const DefaultReaderSize = 312 * 8
+
+var (
+	// ErrNilReader is returned when reading from nil Reader (or Reader with nil PRNG).
+	ErrNilReader = errors.New("nil mt.Reader")
+	// ErrClosed is returned when reading from closed Reader.
+	ErrClosed = errors.New("read from closed mt.Reader")
+)
+
+// Reader is class of pseudo random number generator with io.Reader interface.
+// Reader has a private buffer, and refills it from PRNG in blocks under a single lock.
+// The buffered bytes are discarded if the source of PRNG is seeded or replaced
+// (by Seed, SeedUint64, SeedBytes, SeedArray, Reset or SetSource method),
+// so the bytes after that are generated from the new state.
 type Reader struct {
-	prng *PRNG
-	rn   uint64
-	pos  int8
+	prng    filler
+	mutex   sync.Mutex
+	buf     []byte
+	pos     int
+	gen     uint64 //generation of the source when buf is filled
+	limited bool   //true if the output is limited
+	remain  int64  //remaining bytes if limited
+	closed  atomic.Bool
 }
 
 var _ io.Reader = (*Reader)(nil)     //Reader is compatible with io.Reader interface
 var _ io.ByteReader = (*Reader)(nil) //Reader is compatible with io.ByteReader interface
+var _ io.WriterTo = (*Reader)(nil)   //Reader is compatible with io.WriterTo interface
+var _ io.Closer = (*Reader)(nil)     //Reader is compatible with io.Closer interface
+
+// NewReaderSize returns new Reader instance whose buffer has at least the specified size in bytes
+// (rounded up to a multiple of 8).
+func (prng *PRNG) NewReaderSize(size int) *Reader {
+	if prng == nil {
+		return newReaderSize(nil, size)
+	}
+	return newReaderSize(prng, size)
+}
+
+// NewLimitedReader returns new Reader instance which reads n bytes and then returns io.EOF.
+func (prng *PRNG) NewLimitedReader(n int64) *Reader {
+	r := prng.NewReader()
+	r.limited = true
+	r.remain = max(n, 0)
+	return r
+}
+
+// filler is a concurrency-safe generator which fills a buffer under a single lock (PRNG or Locked).
+// The generation of the source changes when the source is seeded or replaced.
+type filler interface {
+	fill([]byte) (gen uint64)
+	generation() uint64
+}
+
+// newReaderSize returns new Reader instance for f (nil if the generator is nil).
+func newReaderSize(f filler, size int) *Reader {
+	if size < 8 {
+		size = 8
+	}
+	size = (size + 7) &^ 7
+	buf := make([]byte, size)
+	return &Reader{prng: f, buf: buf, pos: size}
+}
 
-//Read reads bytes data from generator (compatible with io.ReadCloser interface)
+// Read reads bytes data from generator (compatible with io.Reader interface).
+// It returns io.EOF only if the Reader is limited (see PRNG.NewLimitedReader method) and all bytes are read.
 func (r *Reader) Read(buf []byte) (int, error) {
-	if r == nil || r.prng == nil {
-		return 0, io.ErrUnexpectedEOF
-	}
-	rest := len(buf)
-	if rest == 0 {
-		return 0, io.ErrUnexpectedEOF
-	}
-	r.prng.mutex.Lock()
-	defer r.prng.mutex.Unlock()
-
-	ct := 0
-	for r.pos > 0 {
-		buf[ct] = r.getByte(false)
-		rest--
-		ct++
-		if rest == 0 {
-			return ct, nil
+	if r == nil {
+		return 0, ErrNilReader
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if err := r.check(); err != nil {
+		return 0, err
+	}
+	r.discardStale()
+	if len(buf) == 0 {
+		return 0, nil
+	}
+	if r.limited {
+		if r.remain == 0 {
+			return 0, io.EOF
 		}
+		if int64(len(buf)) > r.remain {
+			buf = buf[:r.remain]
+		}
+	}
+
+	ct := copy(buf, r.buf[r.pos:])
+	r.pos += ct
+	if rest := len(buf) - ct; rest >= len(r.buf) {
+		// large read: fill buf directly
+		n := rest &^ 7
+		r.prng.fill(buf[ct : ct+n])
+		ct += n
 	}
-	rest8 := rest >> 3 //division by 8
-	for rest8 > 0 {
-		rn := r.prng.source.Uint64()
-		buf[ct] = byte(rn)
-		buf[ct+1] = byte(rn >> 8)
-		buf[ct+2] = byte(rn >> 16)
-		buf[ct+3] = byte(rn >> 24)
-		buf[ct+4] = byte(rn >> 32)
-		buf[ct+5] = byte(rn >> 40)
-		buf[ct+6] = byte(rn >> 48)
-		buf[ct+7] = byte(rn >> 56)
-		rest8--
-		ct += 8
-	}
-	rest &= 0x7
-	for rest > 0 {
-		buf[ct] = r.getByte(true)
-		rest--
-		ct++
+	for ct < len(buf) {
+		r.refill()
+		n := copy(buf[ct:], r.buf)
+		r.pos = n
+		ct += n
+	}
+	if r.limited {
+		r.remain -= int64(ct)
 	}
 	return ct, nil
 }
 
-//Read reads bytes data from generator (compatible with io.ReadCloser interface)
+// ReadByte reads a byte from generator (compatible with io.ByteReader interface).
 func (r *Reader) ReadByte() (byte, error) {
-	buf := [1]byte{}
-	_, err := r.Read(buf[:])
-	return buf[0], err
+	if r == nil {
+		return 0, ErrNilReader
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if err := r.check(); err != nil {
+		return 0, err
+	}
+	r.discardStale()
+	if r.limited {
+		if r.remain == 0 {
+			return 0, io.EOF
+		}
+		r.remain--
+	}
+	if r.pos >= len(r.buf) {
+		r.refill()
+		r.pos = 0
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b, nil
 }
 
-func (r *Reader) getByte(upd bool) (b byte) {
-	if r.pos == 0 {
-		if !upd {
-			return
+// WriteTo writes bytes data from generator to w (compatible with io.WriterTo interface).
+// If the Reader is not limited, it writes until an error occurs (or the Reader is closed).
+func (r *Reader) WriteTo(w io.Writer) (int64, error) {
+	if r == nil {
+		return 0, ErrNilReader
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	var total int64
+	for {
+		if err := r.check(); err != nil {
+			return total, err
+		}
+		r.discardStale()
+		if r.pos >= len(r.buf) {
+			if r.limited && r.remain == 0 {
+				return total, nil
+			}
+			r.refill()
+			r.pos = 0
+		}
+		chunk := r.buf[r.pos:]
+		if r.limited && int64(len(chunk)) > r.remain {
+			chunk = chunk[:r.remain]
+		}
+		if len(chunk) == 0 {
+			return total, nil
+		}
+		n, err := w.Write(chunk)
+		r.pos += n
+		total += int64(n)
+		if r.limited {
+			r.remain -= int64(n)
+		}
+		if err != nil {
+			return total, err
 		}
-		r.rn = r.prng.source.Uint64()
-		r.pos = 7
+		if n < len(chunk) {
+			return total, io.ErrShortWrite
+		}
+	}
+}
+
+// Close closes the Reader (compatible with io.Closer interface).
+// Read, ReadByte and WriteTo methods return ErrClosed after closing.
+func (r *Reader) Close() error {
+	if r == nil {
+		return ErrNilReader
+	}
+	r.closed.Store(true)
+	return nil
+}
+
+// check returns an error if the Reader is not available.
+func (r *Reader) check() error {
+	if r.prng == nil {
+		return ErrNilReader
+	}
+	if r.closed.Load() {
+		return ErrClosed
+	}
+	return nil
+}
+
+// refill fills whole buffer.
+func (r *Reader) refill() {
+	if r.buf == nil {
+		r.buf = make([]byte, DefaultReaderSize)
+	}
+	r.gen = r.prng.fill(r.buf)
+}
+
+// discardStale discards the buffered bytes if the source has been seeded or replaced since the last refill.
+func (r *Reader) discardStale() {
+	if r.pos < len(r.buf) && r.prng.generation() != r.gen {
+		r.pos = len(r.buf)
 	}
-	b = byte(r.rn)
-	r.rn >>= 8
-	r.pos--
+}
+
+// fill fills buf (length is a multiple of 8) with random numbers in little endian, under a single lock.
+// It returns the generation of the source.
+func (prng *PRNG) fill(buf []byte) (gen uint64) {
+	prng.mutex.Lock()
+	fillBytes(prng.source, buf)
+	gen = prng.gen.Load()
+	prng.mutex.Unlock()
 	return
 }
 
+// generation returns the generation of the source.
+func (prng *PRNG) generation() uint64 {
+	return prng.gen.Load()
+}
+
 /* MIT License
  *
  * Copyright 2019 Spiegel
@@ -0,0 +1,340 @@
+package mt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"testing/iotest"
+)
+
+// countBytes returns n bytes of countSource{id: 1} stream
+func countBytes(n int) []byte {
+	src := &countSource{id: 1}
+	buf := make([]byte, (n+7)&^7)
+	for i := 0; i < len(buf); i += 8 {
+		binary.LittleEndian.PutUint64(buf[i:], src.Uint64())
+	}
+	return buf[:n]
+}
+
+func TestReaderSize(t *testing.T) {
+	testCases := []struct {
+		size, res int
+	}{
+		{size: -1, res: 8},
+		{size: 1, res: 8},
+		{size: 8, res: 8},
+		{size: 13, res: 16},
+		{size: DefaultReaderSize, res: DefaultReaderSize},
+	}
+	for _, tc := range testCases {
+		if n := len(New(&countSource{}).NewReaderSize(tc.size).buf); n != tc.res {
+			t.Errorf("size of buffer = %v, want %v.", n, tc.res)
+		}
+	}
+	if n := len(New(&countSource{}).NewReader().buf); n != DefaultReaderSize {
+		t.Errorf("size of buffer = %v, want %v.", n, DefaultReaderSize)
+	}
+}
+
+func TestReaderRefill(t *testing.T) {
+	src := &countSource{id: 1}
+	r := New(src).NewReaderSize(64)
+	buf := [8]byte{}
+	for i := 1; i <= 9; i++ {
+		if _, err := r.Read(buf[:]); err != nil {
+			t.Errorf("Reader.Read() is \"%v\", want nil.", err)
+		}
+		if n := binary.LittleEndian.Uint64(buf[:]); n != 1<<32|uint64(i) {
+			t.Errorf("Reader.Read() = %x, want %x.", n, 1<<32|uint64(i))
+		}
+	}
+	if src.ct != 16 {
+		t.Errorf("Source.Uint64() is called %v times, want %v.", src.ct, 16)
+	}
+}
+
+func TestReaderLarge(t *testing.T) {
+	src := &countSource{id: 1}
+	r := New(src).NewReaderSize(16)
+	b, err := r.ReadByte()
+	if err != nil {
+		t.Errorf("Reader.ReadByte() is \"%v\", want nil.", err)
+	}
+	buf := make([]byte, 101)
+	ct, err := r.Read(buf[1:])
+	if err != nil {
+		t.Errorf("Reader.Read() is \"%v\", want nil.", err)
+	}
+	if ct != 100 {
+		t.Errorf("Reader.Read() = %v bytes, want %v.", ct, 100)
+	}
+	buf[0] = b
+	for i := 0; i+8 <= len(buf); i += 8 {
+		if n := binary.LittleEndian.Uint64(buf[i:]); n != 1<<32|uint64(i/8+1) {
+			t.Errorf("Reader.Read() = %x, want %x.", n, 1<<32|uint64(i/8+1))
+		}
+	}
+	if src.ct != 14 { // 2 (buffer) + 10 (direct) + 2 (buffer)
+		t.Errorf("Source.Uint64() is called %v times, want %v.", src.ct, 14)
+	}
+}
+
+func TestReaderReseed(t *testing.T) {
+	prng := New(&splitMix{})
+	prng.SeedArray([]uint64{1})
+	r := prng.NewReader()
+	want := func(x uint64) []byte { // bytes after reseeding
+		b := make([]byte, 16)
+		src := &splitMix{x: x}
+		binary.LittleEndian.PutUint64(b, src.Uint64())
+		binary.LittleEndian.PutUint64(b[8:], src.Uint64())
+		return b
+	}
+	testCases := []struct {
+		name   string
+		reseed func()
+		res    []byte
+	}{
+		{name: "Seed", reseed: func() { prng.Seed(2) }, res: want(2)},
+		{name: "SeedArray", reseed: func() { prng.SeedArray([]uint64{3}) }, res: want(3)},
+		{name: "Reset", reseed: func() { prng.Reset() }, res: want(3)},
+		{name: "SetSource", reseed: func() { prng.SetSource(&splitMix{x: 4}) }, res: want(4)},
+	}
+	for _, tc := range testCases {
+		if _, err := r.ReadByte(); err != nil { // the rest are buffered
+			t.Errorf("Reader.ReadByte() is \"%v\", want nil.", err)
+		}
+		tc.reseed()
+		buf := make([]byte, 16)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			t.Errorf("Reader.Read() after %v is \"%v\", want nil.", tc.name, err)
+		}
+		if !bytes.Equal(buf, tc.res) {
+			t.Errorf("Reader.Read() after %v = %x, want %x.", tc.name, buf, tc.res)
+		}
+	}
+
+	locked := NewLocked(&splitMix{})
+	r = locked.NewReader()
+	_, _ = r.ReadByte()
+	locked.SeedArray([]uint64{5})
+	var buf bytes.Buffer
+	if _, err := io.CopyN(&buf, r, 16); err != nil {
+		t.Errorf("Locked.NewReader().Read() is \"%v\", want nil.", err)
+	}
+	if res := want(5); !bytes.Equal(buf.Bytes(), res) {
+		t.Errorf("Locked.NewReader() after SeedArray = %x, want %x.", buf.Bytes(), res)
+	}
+}
+
+func TestReaderNoDuplicate(t *testing.T) {
+	prng := New(&countSource{id: 1})
+	shared := prng.NewReaderSize(24)
+	wg := sync.WaitGroup{}
+	mutex := sync.Mutex{}
+	values := map[uint64]int{}
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			r := shared
+			if id%2 == 0 {
+				r = prng.NewReaderSize(40)
+			}
+			vs := make([]uint64, 1000)
+			buf := [8]byte{}
+			for j := range vs {
+				if _, err := r.Read(buf[:]); err != nil {
+					t.Errorf("Reader.Read() is \"%v\", want nil.", err)
+					return
+				}
+				vs[j] = binary.LittleEndian.Uint64(buf[:])
+			}
+			mutex.Lock()
+			for _, v := range vs {
+				values[v]++
+			}
+			mutex.Unlock()
+		}(i)
+	}
+	wg.Wait()
+	for v, ct := range values {
+		if ct != 1 {
+			t.Errorf("value %x is read %v times, want %v.", v, ct, 1)
+		}
+	}
+	if len(values) != 100000 {
+		t.Errorf("%v values are read, want %v.", len(values), 100000)
+	}
+}
+
+func TestReaderZero(t *testing.T) {
+	r := &Reader{prng: New(&countSource{id: 1})}
+	if b, err := r.ReadByte(); err != nil || b != 1 {
+		t.Errorf("Reader.ReadByte() = %v, %v, want %v, nil.", b, err, 1)
+	}
+	if n := len(r.buf); n != DefaultReaderSize {
+		t.Errorf("size of buffer = %v, want %v.", n, DefaultReaderSize)
+	}
+}
+
+func TestReaderIOTest(t *testing.T) {
+	for _, n := range []int{0, 1, 13, 1000, DefaultReaderSize*2 + 5} {
+		r := New(&countSource{id: 1}).NewLimitedReader(int64(n))
+		if err := iotest.TestReader(r, countBytes(n)); err != nil {
+			t.Errorf("iotest.TestReader(%v bytes) is \"%v\", want nil.", n, err)
+		}
+	}
+}
+
+func TestReaderZeroLength(t *testing.T) {
+	r := New(&countSource{id: 1}).NewReader()
+	if n, err := r.Read(nil); n != 0 || err != nil {
+		t.Errorf("Reader.Read(nil) = %v, \"%v\", want %v, nil.", n, err, 0)
+	}
+	r = New(&countSource{id: 1}).NewLimitedReader(0)
+	if n, err := r.Read(nil); n != 0 || err != nil {
+		t.Errorf("Reader.Read(nil) = %v, \"%v\", want %v, nil.", n, err, 0)
+	}
+	if n, err := r.Read(make([]byte, 8)); n != 0 || !errors.Is(err, io.EOF) {
+		t.Errorf("Reader.Read() = %v, \"%v\", want %v, \"%v\".", n, err, 0, io.EOF)
+	}
+}
+
+func TestLimitedReader(t *testing.T) {
+	r := New(&countSource{id: 1}).NewLimitedReader(10)
+	buf := make([]byte, 100)
+	n, err := r.Read(buf)
+	if n != 10 || err != nil {
+		t.Errorf("Reader.Read() = %v, \"%v\", want %v, nil.", n, err, 10)
+	}
+	if !bytes.Equal(buf[:n], countBytes(10)) {
+		t.Errorf("Reader.Read() = %v, want %v.", buf[:n], countBytes(10))
+	}
+	if _, err := r.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("Reader.ReadByte() is \"%v\", want \"%v\".", err, io.EOF)
+	}
+	r = New(&countSource{id: 1}).NewLimitedReader(-1)
+	if _, err := r.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("Reader.ReadByte() is \"%v\", want \"%v\".", err, io.EOF)
+	}
+}
+
+func TestReaderWriteTo(t *testing.T) {
+	for _, n := range []int{0, 10, DefaultReaderSize, 10000} {
+		buf := &bytes.Buffer{}
+		r := New(&countSource{id: 1}).NewLimitedReader(int64(n))
+		if _, err := r.ReadByte(); n > 0 && err != nil {
+			t.Errorf("Reader.ReadByte() is \"%v\", want nil.", err)
+		}
+		ct, err := io.Copy(buf, r)
+		if err != nil {
+			t.Errorf("io.Copy() is \"%v\", want nil.", err)
+		}
+		if n > 0 && (ct != int64(n-1) || !bytes.Equal(buf.Bytes(), countBytes(n)[1:])) {
+			t.Errorf("io.Copy() = %v bytes, want %v bytes.", ct, n-1)
+		}
+	}
+}
+
+// errWriter returns error after n bytes written
+type errWriter struct {
+	n     int
+	short bool
+	f     func()
+}
+
+var errTest = errors.New("test error")
+
+func (w *errWriter) Write(p []byte) (int, error) {
+	if w.f != nil {
+		w.f()
+	}
+	if len(p) <= w.n {
+		w.n -= len(p)
+		return len(p), nil
+	}
+	n := w.n
+	w.n = 0
+	if w.short {
+		return n, nil
+	}
+	return n, errTest
+}
+
+func TestReaderWriteToErr(t *testing.T) {
+	r := New(&countSource{id: 1}).NewReader()
+	if n, err := r.WriteTo(&errWriter{n: 5000}); n != 5000 || !errors.Is(err, errTest) {
+		t.Errorf("Reader.WriteTo() = %v, \"%v\", want %v, \"%v\".", n, err, 5000, errTest)
+	}
+	if n, err := r.WriteTo(&errWriter{n: 100, short: true}); n != 100 || !errors.Is(err, io.ErrShortWrite) {
+		t.Errorf("Reader.WriteTo() = %v, \"%v\", want %v, \"%v\".", n, err, 100, io.ErrShortWrite)
+	}
+	w := &errWriter{n: 1 << 30, f: func() { _ = r.Close() }}
+	if n, err := r.WriteTo(w); n == 0 || !errors.Is(err, ErrClosed) {
+		t.Errorf("Reader.WriteTo() = %v, \"%v\", want \"%v\".", n, err, ErrClosed)
+	}
+}
+
+func TestReaderClose(t *testing.T) {
+	r := New(&countSource{id: 1}).NewReader()
+	if err := r.Close(); err != nil {
+		t.Errorf("Reader.Close() is \"%v\", want nil.", err)
+	}
+	if err := r.Close(); err != nil {
+		t.Errorf("Reader.Close() is \"%v\", want nil.", err)
+	}
+	if _, err := r.Read(make([]byte, 8)); !errors.Is(err, ErrClosed) {
+		t.Errorf("Reader.Read() is \"%v\", want \"%v\".", err, ErrClosed)
+	}
+	if _, err := r.ReadByte(); !errors.Is(err, ErrClosed) {
+		t.Errorf("Reader.ReadByte() is \"%v\", want \"%v\".", err, ErrClosed)
+	}
+	if _, err := r.WriteTo(io.Discard); !errors.Is(err, ErrClosed) {
+		t.Errorf("Reader.WriteTo() is \"%v\", want \"%v\".", err, ErrClosed)
+	}
+}
+
+func TestReaderNilErr(t *testing.T) {
+	for _, r := range []*Reader{nil, (*PRNG)(nil).NewReader(), {}} {
+		if _, err := r.Read(make([]byte, 8)); !errors.Is(err, ErrNilReader) {
+			t.Errorf("Reader.Read() is \"%v\", want \"%v\".", err, ErrNilReader)
+		}
+		if _, err := r.ReadByte(); !errors.Is(err, ErrNilReader) {
+			t.Errorf("Reader.ReadByte() is \"%v\", want \"%v\".", err, ErrNilReader)
+		}
+		if _, err := r.WriteTo(io.Discard); !errors.Is(err, ErrNilReader) {
+			t.Errorf("Reader.WriteTo() is \"%v\", want \"%v\".", err, ErrNilReader)
+		}
+	}
+	if err := (*Reader)(nil).Close(); !errors.Is(err, ErrNilReader) {
+		t.Errorf("Reader.Close() is \"%v\", want \"%v\".", err, ErrNilReader)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
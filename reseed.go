@@ -0,0 +1,261 @@
+package mt
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// EntropyWords is the number of words of seeds array given by EntropySource implementations in this package.
+const EntropyWords = 4
+
+// EntropySource is a source of seeds for reseeding (see Reseeder type).
+type EntropySource interface {
+	// Seeds returns seeds array for SeedArray method of Source.
+	Seeds() ([]uint64, error)
+}
+
+// CryptoEntropy is EntropySource by crypto/rand package.
+type CryptoEntropy struct{}
+
+var _ EntropySource = CryptoEntropy{} //CryptoEntropy is compatible with EntropySource interface
+
+// Seeds returns EntropyWords words read from crypto/rand.Reader.
+func (CryptoEntropy) Seeds() ([]uint64, error) {
+	var buf [EntropyWords * 8]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return nil, err
+	}
+	seeds := make([]uint64, EntropyWords)
+	for i := range seeds {
+		seeds[i] = binary.LittleEndian.Uint64(buf[i*8:])
+	}
+	return seeds, nil
+}
+
+// DeterministicEntropy is EntropySource which gives deterministic seeds (by SplitMix64) for testing.
+// It is concurrency-safe.
+type DeterministicEntropy struct {
+	mutex sync.Mutex
+	x     uint64
+}
+
+var _ EntropySource = (*DeterministicEntropy)(nil) //DeterministicEntropy is compatible with EntropySource interface
+
+// NewDeterministicEntropy returns new DeterministicEntropy instance.
+func NewDeterministicEntropy(seed uint64) *DeterministicEntropy {
+	return &DeterministicEntropy{x: seed}
+}
+
+// Seeds returns the next EntropyWords words of SplitMix64 sequence.
+func (e *DeterministicEntropy) Seeds() ([]uint64, error) {
+	if e == nil {
+		return nil, nil
+	}
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	seeds := make([]uint64, EntropyWords)
+	for i := range seeds {
+		e.x += 0x9e3779b97f4a7c15
+		z := e.x
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		seeds[i] = z ^ (z >> 31)
+	}
+	return seeds, nil
+}
+
+// ReseedPolicy decides when Reseeder reseeds the source.
+type ReseedPolicy interface {
+	// NeedReseed reports whether the source should be reseeded before the next output,
+	// given the number of outputs and the time since the last reseeding.
+	NeedReseed(outputs uint64, last time.Time) bool
+}
+
+// ReseedPolicyFunc is an adapter to use a function as ReseedPolicy.
+type ReseedPolicyFunc func(outputs uint64, last time.Time) bool
+
+// NeedReseed calls f(outputs, last).
+func (f ReseedPolicyFunc) NeedReseed(outputs uint64, last time.Time) bool {
+	return f(outputs, last)
+}
+
+// ReseedAfterOutputs returns ReseedPolicy which reseeds the source every n outputs.
+func ReseedAfterOutputs(n uint64) ReseedPolicy {
+	return ReseedPolicyFunc(func(outputs uint64, _ time.Time) bool {
+		return outputs >= n
+	})
+}
+
+// durationCheck is the interval (in outputs) to read the clock in the policy of ReseedAfterDuration.
+const durationCheck = 64
+
+// ReseedAfterDuration returns ReseedPolicy which reseeds the source when d has passed since the last reseeding.
+// The clock is read every 64 outputs (to keep Uint64 method fast),
+// so the reseeding happens at the first check after d.
+func ReseedAfterDuration(d time.Duration) ReseedPolicy {
+	return ReseedPolicyFunc(func(outputs uint64, last time.Time) bool {
+		return outputs > 0 && outputs%durationCheck == 0 && time.Since(last) >= d
+	})
+}
+
+// ReseedAny returns ReseedPolicy which reseeds the source if any of policies needs reseeding.
+// All policies are evaluated (e.g. a request of ReseedOnDemand is consumed).
+func ReseedAny(policies ...ReseedPolicy) ReseedPolicy {
+	return ReseedPolicyFunc(func(outputs uint64, last time.Time) bool {
+		need := false
+		for _, p := range policies {
+			if p.NeedReseed(outputs, last) {
+				need = true
+			}
+		}
+		return need
+	})
+}
+
+// ReseedOnDemand is ReseedPolicy which reseeds the source before the next output after Request method is called.
+type ReseedOnDemand struct {
+	requested atomic.Bool
+}
+
+var _ ReseedPolicy = (*ReseedOnDemand)(nil) //ReseedOnDemand is compatible with ReseedPolicy interface
+
+// NewReseedOnDemand returns new ReseedOnDemand instance.
+func NewReseedOnDemand() *ReseedOnDemand {
+	return &ReseedOnDemand{}
+}
+
+// Request requests reseeding (it is concurrency-safe and does not wait for the lock of PRNG).
+func (p *ReseedOnDemand) Request() {
+	if p == nil {
+		return
+	}
+	p.requested.Store(true)
+}
+
+// NeedReseed reports whether reseeding is requested, and clears the request.
+func (p *ReseedOnDemand) NeedReseed(uint64, time.Time) bool {
+	if p == nil {
+		return false
+	}
+	return p.requested.Swap(false)
+}
+
+// ReseedEvent is a notification of reseeding by Reseeder.
+type ReseedEvent struct {
+	Time    time.Time // time of reseeding
+	Outputs uint64    // number of outputs since the last reseeding
+	Err     error     // error of EntropySource (the source is not reseeded, and it is retried before the next output)
+}
+
+// Reseeder is a Source which reseeds the underlying source with seeds from EntropySource
+// according to ReseedPolicy. It is not concurrency-safe; use it with PRNG (e.g. New(NewReseeder(...))).
+type Reseeder struct {
+	source  Source
+	entropy EntropySource
+	policy  ReseedPolicy
+	notify  func(ReseedEvent)
+	outputs uint64
+	last    time.Time
+}
+
+var _ Source = (*Reseeder)(nil) //Reseeder is compatible with Source interface
+var _ Seeder = (*Reseeder)(nil) //Reseeder is compatible with Seeder interface
+
+// NewReseeder returns new Reseeder instance.
+// If entropy is nil, CryptoEntropy is used. If policy is nil, the source is never reseeded.
+// notify is called (under the lock of PRNG) at each reseeding if it is not nil.
+func NewReseeder(s Source, entropy EntropySource, policy ReseedPolicy, notify func(ReseedEvent)) *Reseeder {
+	if entropy == nil {
+		entropy = CryptoEntropy{}
+	}
+	return &Reseeder{source: s, entropy: entropy, policy: policy, notify: notify, last: time.Now()}
+}
+
+// Seed initializes the underlying source with a seed, and restarts the policy.
+func (r *Reseeder) Seed(seed int64) {
+	if r == nil {
+		return
+	}
+	seedInt64(r.source, seed)
+	r.restart()
+}
+
+// SeedArray initializes the underlying source with seeds array, and restarts the policy.
+func (r *Reseeder) SeedArray(seeds []uint64) {
+	if r == nil {
+		return
+	}
+	r.source.SeedArray(seeds)
+	r.restart()
+}
+
+// Uint64 generates a random number on [0, 2^64-1]-interval, reseeding the underlying source before it if needed.
+func (r *Reseeder) Uint64() uint64 {
+	if r == nil {
+		return 0
+	}
+	if r.policy != nil && r.policy.NeedReseed(r.outputs, r.last) {
+		r.reseed()
+	}
+	r.outputs++
+	return r.source.Uint64()
+}
+
+// Real generates a random number
+// on [0,1]-real-interval if mode==1,
+// on [0,1)-real-interval if mode==2,
+// on (0,1)-real-interval others
+//
+// Deprecated: use Interval.Float64 method (or Float64 method of PRNG) instead.
+func (r *Reseeder) Real(mode int) float64 {
+	return ModeInterval(mode).Float64(r.Uint64())
+}
+
+// reseed reseeds the underlying source with seeds from EntropySource, and notifies the event.
+// The policy is restarted only if the reseeding succeeds, so it is retried before the next output.
+func (r *Reseeder) reseed() {
+	ev := ReseedEvent{Outputs: r.outputs}
+	seeds, err := r.entropy.Seeds()
+	if err == nil {
+		r.source.SeedArray(seeds)
+		r.restart()
+		ev.Time = r.last
+	} else {
+		ev.Time, ev.Err = time.Now(), err
+	}
+	if r.notify != nil {
+		r.notify(ev)
+	}
+}
+
+// restart restarts counting of the policy.
+func (r *Reseeder) restart() {
+	r.outputs = 0
+	r.last = time.Now()
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
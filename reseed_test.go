@@ -0,0 +1,266 @@
+package mt
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSetSource(t *testing.T) {
+	src1 := &splitMix{x: 1}
+	prng := New(src1)
+	prng.Seed(1)
+	if old := prng.SetSource(&splitMix{x: 2}); old != src1 {
+		t.Errorf("PRNG.SetSource() = %v, want %v.", old, src1)
+	}
+	res := sequence(&splitMix{x: 2}, 10)
+	if r := sequence(prng, 10); !slices.Equal(r, res) {
+		t.Errorf("PRNG.Uint64() after SetSource() = %v, want %v.", r, res)
+	}
+	prng.Reset() // does nothing
+	if r, res := prng.Uint64(), sequence(&splitMix{x: 2}, 11)[10]; r != res {
+		t.Errorf("PRNG.Reset() after SetSource() changes the source: %v, want %v.", r, res)
+	}
+	if old := prng.SetSource(nil); old != nil {
+		t.Errorf("PRNG.SetSource(nil) = %v, want nil.", old)
+	}
+	if r, res := prng.Uint64(), sequence(&splitMix{x: 2}, 12)[11]; r != res {
+		t.Errorf("PRNG.SetSource(nil) replaces the source: %v, want %v.", r, res)
+	}
+	if old := (*PRNG)(nil).SetSource(src1); old != nil {
+		t.Errorf("<nil>.SetSource() = %v, want nil.", old)
+	}
+}
+
+func TestSetSourceConcurrency(t *testing.T) {
+	prng := New(&splitMix{x: 1})
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				_ = prng.Uint64()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				prng.SetSource(&splitMix{x: uint64(j)})
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestReseedAfterOutputs(t *testing.T) {
+	var events []ReseedEvent
+	r := NewReseeder(&splitMix{x: 1}, NewDeterministicEntropy(1), ReseedAfterOutputs(10), func(ev ReseedEvent) {
+		events = append(events, ev)
+	})
+	out := sequence(r, 35)
+	if len(events) != 3 {
+		t.Fatalf("number of reseedings = %v, want %v.", len(events), 3)
+	}
+	for _, ev := range events {
+		if ev.Outputs != 10 || ev.Err != nil || ev.Time.IsZero() {
+			t.Errorf("ReseedEvent = %+v, want 10 outputs without error.", ev)
+		}
+	}
+	if res := sequence(&splitMix{x: 1}, 10); !slices.Equal(out[:10], res) {
+		t.Errorf("outputs before reseeding = %v, want %v.", out[:10], res)
+	}
+	entropy := NewDeterministicEntropy(1)
+	for i := 1; i <= 3; i++ {
+		seeds, _ := entropy.Seeds()
+		src := &splitMix{}
+		src.SeedArray(seeds)
+		res := sequence(src, 10)
+		if end := min(i*10+10, len(out)); !slices.Equal(out[i*10:end], res[:end-i*10]) {
+			t.Errorf("outputs after %v-th reseeding = %v, want %v.", i, out[i*10:end], res[:end-i*10])
+		}
+	}
+}
+
+func TestReseedAfterDuration(t *testing.T) {
+	ct := 0
+	r := NewReseeder(&splitMix{x: 1}, NewDeterministicEntropy(1), ReseedAfterDuration(20*time.Millisecond), func(ev ReseedEvent) {
+		ct++
+	})
+	_ = sequence(r, 100)
+	if ct != 0 {
+		t.Errorf("number of reseedings before duration = %v, want %v.", ct, 0)
+	}
+	time.Sleep(30 * time.Millisecond)
+	_ = sequence(r, 100) // the clock is read at the 128th output
+	if ct != 1 {
+		t.Errorf("number of reseedings after duration = %v, want %v.", ct, 1)
+	}
+}
+
+func TestReseedOnDemand(t *testing.T) {
+	ct := 0
+	policy := NewReseedOnDemand()
+	prng := New(NewReseeder(&splitMix{x: 1}, NewDeterministicEntropy(1), policy, func(ev ReseedEvent) {
+		ct++
+	}))
+	_ = sequence(prng, 10)
+	policy.Request()
+	policy.Request()
+	_ = sequence(prng, 10)
+	if ct != 1 {
+		t.Errorf("number of reseedings = %v, want %v.", ct, 1)
+	}
+	if (*ReseedOnDemand)(nil).NeedReseed(0, time.Time{}) {
+		t.Error("<nil>.NeedReseed() = true, want false.")
+	}
+}
+
+func TestReseedAny(t *testing.T) {
+	ct := 0
+	demand := NewReseedOnDemand()
+	r := NewReseeder(&splitMix{x: 1}, NewDeterministicEntropy(1), ReseedAny(ReseedAfterOutputs(10), demand), func(ev ReseedEvent) {
+		ct++
+	})
+	_ = sequence(r, 25) // 2 reseedings
+	demand.Request()
+	_ = r.Uint64()
+	if ct != 3 {
+		t.Errorf("number of reseedings = %v, want %v.", ct, 3)
+	}
+}
+
+type errEntropy struct{}
+
+var errNoEntropy = errors.New("no entropy")
+
+func (errEntropy) Seeds() ([]uint64, error) { return nil, errNoEntropy }
+
+func TestReseedError(t *testing.T) {
+	var events []ReseedEvent
+	r := NewReseeder(&splitMix{x: 1}, errEntropy{}, ReseedAfterOutputs(10), func(ev ReseedEvent) {
+		events = append(events, ev)
+	})
+	if out, res := sequence(r, 20), sequence(&splitMix{x: 1}, 20); !slices.Equal(out, res) {
+		t.Errorf("outputs with reseeding error = %v, want %v.", out, res)
+	}
+	if len(events) != 10 {
+		t.Fatalf("number of ReseedEvent = %v, want %v.", len(events), 10)
+	}
+	for i, ev := range events {
+		if !errors.Is(ev.Err, errNoEntropy) || ev.Outputs != uint64(10+i) {
+			t.Errorf("ReseedEvent[%v] = %+v, want error \"%v\" after %v outputs.", i, ev, errNoEntropy, 10+i)
+		}
+	}
+}
+
+// flakyEntropy fails n times, and then returns seeds of DeterministicEntropy.
+type flakyEntropy struct {
+	n       int
+	entropy *DeterministicEntropy
+}
+
+func (e *flakyEntropy) Seeds() ([]uint64, error) {
+	if e.n > 0 {
+		e.n--
+		return nil, errNoEntropy
+	}
+	return e.entropy.Seeds()
+}
+
+func TestReseedRetry(t *testing.T) {
+	var events []ReseedEvent
+	r := NewReseeder(&splitMix{x: 1}, &flakyEntropy{n: 2, entropy: NewDeterministicEntropy(1)}, ReseedAfterOutputs(10), func(ev ReseedEvent) {
+		events = append(events, ev)
+	})
+	_ = sequence(r, 22) // 2 errors (after 10 and 11 outputs), reseeding after 12 outputs
+	if len(events) != 3 {
+		t.Fatalf("number of ReseedEvent = %v, want %v.", len(events), 3)
+	}
+	for i, ev := range events {
+		if wantErr := i < 2; (ev.Err != nil) != wantErr || ev.Outputs != uint64(10+i) {
+			t.Errorf("ReseedEvent[%v] = %+v, want %v outputs (error: %v).", i, ev, 10+i, wantErr)
+		}
+	}
+	_ = r.Uint64() // 10 outputs after the reseeding
+	if len(events) != 4 || events[3].Err != nil || events[3].Outputs != 10 {
+		t.Errorf("ReseedEvent = %+v, want reseeding after %v outputs.", events, 10)
+	}
+}
+
+func TestReseederSeed(t *testing.T) {
+	ct := 0
+	r := NewReseeder(&seedSource{}, nil, ReseedAfterOutputs(10), func(ev ReseedEvent) {
+		ct++
+	})
+	_ = sequence(r, 5)
+	r.Seed(1) // restarts the policy
+	src := &seedSource{}
+	src.Seed(1)
+	if out, res := sequence(r, 10), sequence(src, 10); !slices.Equal(out, res) {
+		t.Errorf("Reseeder.Seed() sequence = %v, want %v.", out, res)
+	}
+	if ct != 0 {
+		t.Errorf("number of reseedings = %v, want %v.", ct, 0)
+	}
+	r.SeedArray([]uint64{1, 2})
+	_ = sequence(r, 11)
+	if ct != 1 {
+		t.Errorf("number of reseedings = %v, want %v.", ct, 1)
+	}
+}
+
+func TestEntropy(t *testing.T) {
+	s1, err := CryptoEntropy{}.Seeds()
+	if err != nil || len(s1) != EntropyWords {
+		t.Fatalf("CryptoEntropy.Seeds() = %v, \"%v\", want %v words.", s1, err, EntropyWords)
+	}
+	if s2, _ := (CryptoEntropy{}).Seeds(); slices.Equal(s1, s2) {
+		t.Errorf("CryptoEntropy.Seeds() returns the same seeds twice: %v.", s1)
+	}
+	e1, e2 := NewDeterministicEntropy(1), NewDeterministicEntropy(1)
+	for i := 0; i < 3; i++ {
+		s1, _ := e1.Seeds()
+		s2, _ := e2.Seeds()
+		if len(s1) != EntropyWords || !slices.Equal(s1, s2) {
+			t.Errorf("DeterministicEntropy.Seeds() = %v, %v, want the same %v words.", s1, s2, EntropyWords)
+		}
+	}
+}
+
+func TestReseederNil(t *testing.T) {
+	r := (*Reseeder)(nil)
+	r.Seed(1)
+	r.SeedArray(nil)
+	if v := r.Uint64(); v != 0 {
+		t.Errorf("<nil>.Uint64() = %v, want %v.", v, 0)
+	}
+	if s, err := (*DeterministicEntropy)(nil).Seeds(); s != nil || err != nil {
+		t.Errorf("<nil>.Seeds() = %v, \"%v\", want nil, nil.", s, err)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -8,17 +8,23 @@ import (
 	"math"
 	"math/rand/v2"
 
+	"github.com/goark/mt/v2/dist"
 	"github.com/goark/mt/v2/mt19937"
 )
 
 func main() {
-	rnd := rand.New(mt19937.New(rand.Int64()))
+	rnd := mt19937.New(rand.Int64())
+	norm, err := dist.NewNormal(0, 1)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 	points := []float64{}
 	max := 0.0
 	min := 1.0
 	sum := 0.0
 	for range 10000 {
-		point := rnd.NormFloat64()
+		point := norm.Rand(rnd)
 		points = append(points, point)
 		min = math.Min(min, point)
 		max = math.Max(max, point)
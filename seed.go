@@ -0,0 +1,106 @@
+package mt
+
+import "encoding/binary"
+
+// Seeder is an optional extension of Source interface: a source which can be initialized with a seed
+// (e.g. *mt19937.Source). Seed methods of PRNG use it if the source implements it.
+type Seeder interface {
+	Seed(int64)
+}
+
+var _ Seeder = (*PRNG)(nil) //PRNG is compatible with Seeder interface
+
+// Seed initializes the source with a seed.
+// If the source does not implement Seeder interface, SeedArray([]uint64{uint64(seed)}) is used instead.
+func (prng *PRNG) Seed(seed int64) {
+	prng.reseed(func(s Source) { seedInt64(s, seed) })
+}
+
+// SeedUint64 initializes the source with a seed (the same as Seed(int64(seed))).
+func (prng *PRNG) SeedUint64(seed uint64) {
+	prng.Seed(int64(seed))
+}
+
+// SeedBytes initializes the source with a seed of bytes (e.g. a passphrase or a hash value).
+// The bytes are converted to seeds array by SeedsFromBytes function.
+func (prng *PRNG) SeedBytes(b []byte) {
+	seeds := SeedsFromBytes(b)
+	prng.reseed(func(s Source) { s.SeedArray(seeds) })
+}
+
+// Reset initializes the source again with the last seed given by Seed, SeedUint64, SeedBytes or SeedArray method,
+// so the same sequence is generated again.
+// If the source has not been seeded by these methods, Reset does nothing.
+func (prng *PRNG) Reset() {
+	if prng == nil {
+		return
+	}
+	prng.mutex.Lock()
+	if prng.seed != nil {
+		prng.seed(prng.source)
+		prng.gen.Add(1)
+	}
+	prng.mutex.Unlock()
+}
+
+// reseed initializes the source by f under the lock, and keeps f for Reset method.
+func (prng *PRNG) reseed(f func(s Source)) {
+	if prng == nil {
+		return
+	}
+	prng.mutex.Lock()
+	prng.seed = f
+	f(prng.source)
+	prng.gen.Add(1)
+	prng.mutex.Unlock()
+}
+
+// SeedsFromBytes converts bytes to seeds array for SeedArray method:
+// the bytes are split into uint64 words in little endian (the last word is padded with zeros),
+// and the length of b is appended so that different lengths give different seeds.
+func SeedsFromBytes(b []byte) []uint64 {
+	n := uint64(len(b))
+	seeds := make([]uint64, 0, len(b)/8+2)
+	for len(b) >= 8 {
+		seeds = append(seeds, binary.LittleEndian.Uint64(b))
+		b = b[8:]
+	}
+	if len(b) > 0 {
+		var w [8]byte
+		copy(w[:], b)
+		seeds = append(seeds, binary.LittleEndian.Uint64(w[:]))
+	}
+	return append(seeds, n)
+}
+
+// seedInt64 initializes src with a seed.
+func seedInt64(src Source, seed int64) {
+	if s, ok := src.(Seeder); ok {
+		s.Seed(seed)
+		return
+	}
+	src.SeedArray([]uint64{uint64(seed)})
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,137 @@
+package mt
+
+import (
+	"slices"
+	"testing"
+)
+
+// mockup for test (splitMix with Seed method)
+type seedSource struct {
+	splitMix
+}
+
+func (s *seedSource) Seed(seed int64) { s.x = uint64(seed) * 3 }
+
+func sequence(src Source, n int) []uint64 {
+	res := make([]uint64, n)
+	for i := range res {
+		res[i] = src.Uint64()
+	}
+	return res
+}
+
+func TestSeed(t *testing.T) {
+	testCases := []struct {
+		name string
+		seed func(prng *PRNG)
+		raw  func(s *seedSource)
+	}{
+		{name: "Seed", seed: func(p *PRNG) { p.Seed(-19650218) }, raw: func(s *seedSource) { s.Seed(-19650218) }},
+		{name: "SeedUint64", seed: func(p *PRNG) { p.SeedUint64(1 << 63) }, raw: func(s *seedSource) { s.Seed(-1 << 63) }},
+		{name: "SeedBytes", seed: func(p *PRNG) { p.SeedBytes([]byte("passphrase")) }, raw: func(s *seedSource) { s.SeedArray(SeedsFromBytes([]byte("passphrase"))) }},
+		{name: "SeedArray", seed: func(p *PRNG) { p.SeedArray([]uint64{1, 2, 3}) }, raw: func(s *seedSource) { s.SeedArray([]uint64{1, 2, 3}) }},
+	}
+	for _, tc := range testCases {
+		src := &seedSource{splitMix{x: 12345}}
+		tc.raw(src)
+		res := sequence(src, 100)
+
+		prng := New(&seedSource{})
+		tc.seed(prng)
+		if r := sequence(prng, 100); !slices.Equal(r, res) {
+			t.Errorf("PRNG.%v() sequence is not the same as Source.", tc.name)
+		}
+		prng.Reset()
+		if r := sequence(prng, 100); !slices.Equal(r, res) {
+			t.Errorf("PRNG.Reset() after %v() sequence is not the same as Source.", tc.name)
+		}
+	}
+}
+
+func TestSeedWithoutSeeder(t *testing.T) {
+	src := &splitMix{}
+	src.SeedArray([]uint64{19650218})
+	res := sequence(src, 100)
+	prng := New(&splitMix{})
+	prng.Seed(19650218)
+	if r := sequence(prng, 100); !slices.Equal(r, res) {
+		t.Error("PRNG.Seed() without Seeder is not the same as SeedArray().")
+	}
+	prng.Reset()
+	if r := sequence(prng, 100); !slices.Equal(r, res) {
+		t.Error("PRNG.Reset() without Seeder is not the same as SeedArray().")
+	}
+}
+
+func TestSeedArrayClone(t *testing.T) {
+	seeds := []uint64{1, 2, 3}
+	prng := New(&splitMix{})
+	prng.SeedArray(seeds)
+	res := sequence(prng, 10)
+	seeds[0] = 100 // changing seeds after SeedArray does not affect Reset
+	prng.Reset()
+	if r := sequence(prng, 10); !slices.Equal(r, res) {
+		t.Error("PRNG.Reset() is affected by changing seeds array.")
+	}
+}
+
+func TestResetWithoutSeed(t *testing.T) {
+	prng := New(&splitMix{x: 1})
+	_ = prng.Uint64()
+	prng.Reset()
+	if r, res := prng.Uint64(), sequence(&splitMix{x: 1}, 2)[1]; r != res {
+		t.Errorf("PRNG.Reset() without seed changes the source: %v, want %v.", r, res)
+	}
+}
+
+func TestSeedsFromBytes(t *testing.T) {
+	testCases := []struct {
+		b     []byte
+		seeds []uint64
+	}{
+		{b: nil, seeds: []uint64{0}},
+		{b: []byte{1}, seeds: []uint64{1, 1}},
+		{b: []byte{1, 0}, seeds: []uint64{1, 2}},
+		{b: []byte{1, 2, 3, 4, 5, 6, 7, 8}, seeds: []uint64{0x0807060504030201, 8}},
+		{b: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9}, seeds: []uint64{0x0807060504030201, 9, 9}},
+	}
+	for _, tc := range testCases {
+		if seeds := SeedsFromBytes(tc.b); !slices.Equal(seeds, tc.seeds) {
+			t.Errorf("SeedsFromBytes(%v) = %v, want %v.", tc.b, seeds, tc.seeds)
+		}
+	}
+}
+
+func TestSeedNil(t *testing.T) {
+	prng := (*PRNG)(nil)
+	prng.Seed(1)
+	prng.SeedUint64(1)
+	prng.SeedBytes([]byte{1})
+	prng.Reset()
+	if r := prng.Uint64(); r != 0 {
+		t.Errorf("PRNG.Uint64() = %v, want %v.", r, 0)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,67 @@
+package sfmt_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/goark/mt/v2"
+	"github.com/goark/mt/v2/sfmt"
+)
+
+func ExampleNew() {
+	fmt.Println(sfmt.New(1234).Uint32())
+	//Output:
+	//3440181298
+}
+
+func ExampleNewExp() {
+	s, err := sfmt.NewExp(sfmt.MEXP607, 1234)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(s.IDString())
+	fmt.Println(rand.New(s).IntN(1000))
+	//Output:
+	//SFMT-607:2-15-3-13-3:fdff37ff-ef7f3f7d-ff777b7d-7ff7fb2f
+	//667
+}
+
+func ExampleSource_FillUint64s() {
+	s := sfmt.New(1234)
+	buf := make([]uint64, s.MinArraySize()*4)
+	s.FillUint64s(buf)
+	fmt.Println(buf[0])
+	//Output:
+	//6721611276080709682
+}
+
+func ExampleSource_withPRNG() {
+	prng := mt.New(sfmt.New(1234)) // concurrency-safe
+	fmt.Println(prng.Uint64())
+	//Output:
+	//6721611276080709682
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,165 @@
+package sfmt
+
+// Exponent is a Mersenne exponent of SFMT (the period is a multiple of 2^Exponent-1).
+type Exponent int
+
+// Mersenne exponents of SFMT
+const (
+	MEXP607    Exponent = 607
+	MEXP1279   Exponent = 1279
+	MEXP2281   Exponent = 2281
+	MEXP4253   Exponent = 4253
+	MEXP11213  Exponent = 11213
+	MEXP19937  Exponent = 19937
+	MEXP44497  Exponent = 44497
+	MEXP86243  Exponent = 86243
+	MEXP132049 Exponent = 132049
+	MEXP216091 Exponent = 216091
+)
+
+// params is a parameter set of SFMT (SFMT-params*.h).
+type params struct {
+	mexp     Exponent
+	pos1     int
+	sl1, sl2 uint
+	sr1, sr2 uint
+	msk      [4]uint32
+	parity   [4]uint32
+	idstr    string
+}
+
+var paramsList = []*params{
+	{
+		mexp: MEXP607, pos1: 2, sl1: 15, sl2: 3, sr1: 13, sr2: 3,
+		msk:    [4]uint32{0xfdff37ff, 0xef7f3f7d, 0xff777b7d, 0x7ff7fb2f},
+		parity: [4]uint32{0x00000001, 0x00000000, 0x00000000, 0x5986f054},
+		idstr:  "SFMT-607:2-15-3-13-3:fdff37ff-ef7f3f7d-ff777b7d-7ff7fb2f",
+	},
+	{
+		mexp: MEXP1279, pos1: 7, sl1: 14, sl2: 3, sr1: 5, sr2: 1,
+		msk:    [4]uint32{0xf7fefffd, 0x7fefcfff, 0xaff3ef3f, 0xb5ffff7f},
+		parity: [4]uint32{0x00000001, 0x00000000, 0x00000000, 0x20000000},
+		idstr:  "SFMT-1279:7-14-3-5-1:f7fefffd-7fefcfff-aff3ef3f-b5ffff7f",
+	},
+	{
+		mexp: MEXP2281, pos1: 12, sl1: 19, sl2: 1, sr1: 5, sr2: 1,
+		msk:    [4]uint32{0xbff7ffbf, 0xfdfffffe, 0xf7ffef7f, 0xf2f7cbbf},
+		parity: [4]uint32{0x00000001, 0x00000000, 0x00000000, 0x41dfa600},
+		idstr:  "SFMT-2281:12-19-1-5-1:bff7ffbf-fdfffffe-f7ffef7f-f2f7cbbf",
+	},
+	{
+		mexp: MEXP4253, pos1: 17, sl1: 20, sl2: 1, sr1: 7, sr2: 1,
+		msk:    [4]uint32{0x9f7bffff, 0x9fffff5f, 0x3efffffb, 0xfffff7bb},
+		parity: [4]uint32{0xa8000001, 0xaf5390a3, 0xb740b3f8, 0x6c11486d},
+		idstr:  "SFMT-4253:17-20-1-7-1:9f7bffff-9fffff5f-3efffffb-fffff7bb",
+	},
+	{
+		mexp: MEXP11213, pos1: 68, sl1: 14, sl2: 3, sr1: 7, sr2: 3,
+		msk:    [4]uint32{0xeffff7fb, 0xffffffef, 0xdfdfbfff, 0x7fffdbfd},
+		parity: [4]uint32{0x00000001, 0x00000000, 0xe8148000, 0xd0c7afa3},
+		idstr:  "SFMT-11213:68-14-3-7-3:effff7fb-ffffffef-dfdfbfff-7fffdbfd",
+	},
+	{
+		mexp: MEXP19937, pos1: 122, sl1: 18, sl2: 1, sr1: 11, sr2: 1,
+		msk:    [4]uint32{0xdfffffef, 0xddfecb7f, 0xbffaffff, 0xbffffff6},
+		parity: [4]uint32{0x00000001, 0x00000000, 0x00000000, 0x13c9e684},
+		idstr:  "SFMT-19937:122-18-1-11-1:dfffffef-ddfecb7f-bffaffff-bffffff6",
+	},
+	{
+		mexp: MEXP44497, pos1: 330, sl1: 5, sl2: 3, sr1: 9, sr2: 3,
+		msk:    [4]uint32{0xeffffffb, 0xdfbebfff, 0xbfbf7bef, 0x9ffd7bff},
+		parity: [4]uint32{0x00000001, 0x00000000, 0xa3ac4000, 0xecc1327a},
+		idstr:  "SFMT-44497:330-5-3-9-3:effffffb-dfbebfff-bfbf7bef-9ffd7bff",
+	},
+	{
+		mexp: MEXP86243, pos1: 366, sl1: 6, sl2: 7, sr1: 19, sr2: 1,
+		msk:    [4]uint32{0xfdbffbff, 0xbff7ff3f, 0xfd77efff, 0xbf9ff3ff},
+		parity: [4]uint32{0x00000001, 0x00000000, 0x00000000, 0xe9528d85},
+		idstr:  "SFMT-86243:366-6-7-19-1:fdbffbff-bff7ff3f-fd77efff-bf9ff3ff",
+	},
+	{
+		mexp: MEXP132049, pos1: 110, sl1: 19, sl2: 1, sr1: 21, sr2: 1,
+		msk:    [4]uint32{0xffffbb5f, 0xfb6ebf95, 0xfffefffa, 0xcff77fff},
+		parity: [4]uint32{0x00000001, 0x00000000, 0xcb520000, 0xc7e91c7d},
+		idstr:  "SFMT-132049:110-19-1-21-1:ffffbb5f-fb6ebf95-fffefffa-cff77fff",
+	},
+	{
+		mexp: MEXP216091, pos1: 627, sl1: 11, sl2: 3, sr1: 10, sr2: 1,
+		msk:    [4]uint32{0xbff7bff7, 0xbfffffff, 0xbffffa7f, 0xffddfbfb},
+		parity: [4]uint32{0xf8000001, 0x89e80709, 0x3bd2b64b, 0x0c64b1e4},
+		idstr:  "SFMT-216091:627-11-3-10-1:bff7bff7-bfffffff-bffffa7f-ffddfbfb",
+	},
+}
+
+// kernel is a set of shifts and masks for the recursion formula in 64-bit operations.
+// Shifts of each 32-bit lane are emulated by 64-bit shifts with masks,
+// and shifts of 128-bit words are emulated by pairs of 64-bit shifts.
+type kernel struct {
+	sl1, sr1   uint   //shifts of 32-bit lanes
+	sl2, sr2   uint   //shifts of 128-bit words in bits
+	sl2c, sr2c uint   //complements of sl2 and sr2 (64-sl2, 64-sr2)
+	msk0, msk1 uint64 //masks of b>>sr1 (lower and upper 64 bits)
+	lmask      uint64 //mask of d<<sl1
+}
+
+// kernel returns the kernel of the parameter set.
+// Shifts are masked by 63 to avoid checks of over-shifting.
+func (p *params) kernel() kernel {
+	rmask := uint64(0xffffffff>>p.sr1) * 0x0000000100000001
+	return kernel{
+		sl1:   p.sl1 & 63,
+		sr1:   p.sr1 & 63,
+		sl2:   p.sl2 * 8 & 63,
+		sr2:   p.sr2 * 8 & 63,
+		sl2c:  (64 - p.sl2*8) & 63,
+		sr2c:  (64 - p.sr2*8) & 63,
+		msk0:  (uint64(p.msk[1])<<32 | uint64(p.msk[0])) & rmask,
+		msk1:  (uint64(p.msk[3])<<32 | uint64(p.msk[2])) & rmask,
+		lmask: uint64(0xffffffff<<p.sl1&0xffffffff) * 0x0000000100000001,
+	}
+}
+
+// recursion is the recursion formula of SFMT (do_recursion function) for 128-bit words a, b, c and d.
+// a and b are pairs of lower and upper 64 bits.
+func (k *kernel) recursion(a, b []uint64, c0, c1, d0, d1 uint64) (uint64, uint64) {
+	return a[0] ^ a[0]<<k.sl2 ^ ((b[0] >> k.sr1) & k.msk0) ^ (c0>>k.sr2 | c1<<k.sr2c) ^ ((d0 << k.sl1) & k.lmask),
+		a[1] ^ (a[1]<<k.sl2 | a[0]>>k.sl2c) ^ ((b[1] >> k.sr1) & k.msk1) ^ c1>>k.sr2 ^ ((d1 << k.sl1) & k.lmask)
+}
+
+// n returns the size of state array in 128-bit words.
+func (p *params) n() int {
+	return int(p.mexp)/128 + 1
+}
+
+// lookup returns the parameter set of Exponent.
+func lookup(mexp Exponent) *params {
+	for _, p := range paramsList {
+		if p.mexp == mexp {
+			return p
+		}
+	}
+	return nil
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,100 @@
+package sfmt
+
+import (
+	"math/bits"
+	"slices"
+	"testing"
+
+	"github.com/goark/mt/v2/gf2"
+)
+
+// TestCharacteristicPolynomial checks that the characteristic polynomial of SFMT
+// has an irreducible factor of degree MEXP (and the period is a multiple of 2^MEXP-1),
+// and that the parity vector certifies the period.
+// gcd(x^(2^MEXP)-x, f) is a product of irreducible factors of f of degree 1 and MEXP (MEXP is prime).
+func TestCharacteristicPolynomial(t *testing.T) {
+	for _, mexp := range []Exponent{MEXP607, MEXP1279, MEXP2281, MEXP4253} {
+		if mexp > MEXP2281 && testing.Short() {
+			continue
+		}
+		s, _ := NewExp(mexp, 1234)
+		n := 2 * 128 * (len(s.state) / 2)
+		seq := make([]uint64, n/64+1)
+		for i := 0; i < n; i++ {
+			seq[i/64] |= uint64(s.Uint32()&1) << (i % 64)
+			s.Uint32() // the sequence of 128-bit words
+			s.Uint32()
+			s.Uint32()
+		}
+		f := gf2.BerlekampMassey(seq, n)
+
+		// gcd(x^(2^MEXP)-x, f)
+		x := gf2.NewModulus(f).ExpX2(int(mexp))
+		x.Flip(1)
+		p := gf2.GCD(f, x)
+		if d := p.Degree(); d != int(mexp) && d != int(mexp)+1 && d != int(mexp)+2 {
+			t.Errorf("degree of gcd(x^(2^%[1]v)-x, f) = %[2]v, want %[1]v (+ factors of degree 1).", mexp, d)
+			continue
+		}
+		for _, r := range []gf2.Poly{{0b10}, {0b11}} { // x and x+1
+			if q, rem := gf2.DivMod(p, r); rem.IsZero() {
+				p = q
+			}
+		}
+		if !checkParity(s.p, p) {
+			t.Errorf("parity vector of %v does not certify the period.", mexp)
+		}
+	}
+}
+
+// checkParity checks that the parity vector vanishes on the states out of the period 2^MEXP-1.
+// They are p(T)z for states z, where p is the irreducible factor of degree MEXP
+// of the characteristic polynomial and T is the state transition.
+// The first 128-bit word of p(T)z is the sum of p_i*w_i, where w_i is the i-th 128-bit word from z.
+func checkParity(prm *params, p gf2.Poly) bool {
+	z := New(5489)
+	for k := 0; k < 16; k++ {
+		s := &Source{p: prm, state: make([]uint64, prm.n()*2)}
+		z.FillUint64s(s.state)
+		s.idx = len(s.state) * 2
+		w := slices.Clone(s.state)
+		var u [2]uint64
+		for i := 0; i <= p.Degree(); i++ {
+			if i >= prm.n() {
+				w = append(w, s.Uint64(), s.Uint64())
+			}
+			if p.Coeff(i) != 0 {
+				u[0] ^= w[2*i]
+				u[1] ^= w[2*i+1]
+			}
+		}
+		inner := u[0]&(uint64(prm.parity[1])<<32|uint64(prm.parity[0])) ^ u[1]&(uint64(prm.parity[3])<<32|uint64(prm.parity[2]))
+		if bits.OnesCount64(inner)&1 != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
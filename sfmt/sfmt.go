@@ -0,0 +1,380 @@
+package sfmt
+
+import (
+	"errors"
+
+	"github.com/goark/mt/v2"
+)
+
+// ErrUnsupportedExponent is returned when Exponent is not supported.
+var ErrUnsupportedExponent = errors.New("unsupported Mersenne exponent of SFMT")
+
+// Source is a source of random numbers (SFMT; SIMD-oriented Fast Mersenne Twister).
+type Source struct {
+	p     *params
+	state []uint64 //The array for the state vector (pairs of 64-bit words are 128-bit words)
+	idx   int      //index of state in 32-bit words; idx>=len(state)*2 means state must be regenerated
+}
+
+var _ mt.Source = (*Source)(nil) //Source is compatible with mt.Source interface
+var _ mt.Seeder = (*Source)(nil) //Source is compatible with mt.Seeder interface
+
+// New returns a new pseudo-random source (SFMT19937) seeded with the given value.
+// Only the lower 32 bits of seed are used (same as sfmt_init_gen_rand function).
+func New(seed int64) *Source {
+	s, _ := NewExp(MEXP19937, seed)
+	return s
+}
+
+// NewWithArray returns a new pseudo-random source (SFMT19937) seeded with the given values.
+// Only the lower 32 bits of each seed are used (same as sfmt_init_by_array function).
+func NewWithArray(seeds []uint64) *Source {
+	s, _ := NewExpWithArray(MEXP19937, seeds)
+	return s
+}
+
+// NewExp returns a new pseudo-random source of the Mersenne exponent, seeded with the given value.
+func NewExp(mexp Exponent, seed int64) (*Source, error) {
+	s, err := newSource(mexp)
+	if err != nil {
+		return nil, err
+	}
+	s.Seed(seed)
+	return s, nil
+}
+
+// NewExpWithArray returns a new pseudo-random source of the Mersenne exponent, seeded with the given values.
+func NewExpWithArray(mexp Exponent, seeds []uint64) (*Source, error) {
+	s, err := newSource(mexp)
+	if err != nil {
+		return nil, err
+	}
+	s.SeedArray(seeds)
+	return s, nil
+}
+
+func newSource(mexp Exponent) (*Source, error) {
+	p := lookup(mexp)
+	if p == nil {
+		return nil, ErrUnsupportedExponent
+	}
+	return &Source{p: p, state: make([]uint64, p.n()*2)}, nil
+}
+
+// Exponent returns the Mersenne exponent of Source.
+func (s *Source) Exponent() Exponent {
+	if s == nil || s.p == nil {
+		return 0
+	}
+	return s.p.mexp
+}
+
+// IDString returns the identification string of Source (same as sfmt_get_idstring function).
+func (s *Source) IDString() string {
+	if s == nil || s.p == nil {
+		return ""
+	}
+	return s.p.idstr
+}
+
+// MinArraySize returns the minimum size of arrays in 64-bit words for fast bulk generation
+// (see FillUint64s method).
+func (s *Source) MinArraySize() int {
+	if s == nil || s.p == nil {
+		return 0
+	}
+	return len(s.state)
+}
+
+// Seed initializes Source with a seed
+func (s *Source) Seed(seed int64) {
+	if s == nil {
+		return
+	}
+	s.init()
+	st := make([]uint32, len(s.state)*2)
+	st[0] = uint32(seed)
+	for i := 1; i < len(st); i++ {
+		st[i] = 1812433253*(st[i-1]^(st[i-1]>>30)) + uint32(i)
+	}
+	s.setState(st)
+}
+
+// SeedArray initializes Source with seeds array
+func (s *Source) SeedArray(seeds []uint64) {
+	if s == nil {
+		return
+	}
+	s.init()
+	size := len(s.state) * 2
+	lag := 3
+	switch {
+	case size >= 623:
+		lag = 11
+	case size >= 68:
+		lag = 7
+	case size >= 39:
+		lag = 5
+	}
+	mid := (size - lag) / 2
+
+	st := make([]uint32, size)
+	for i := range st {
+		st[i] = 0x8b8b8b8b
+	}
+	count := size
+	if len(seeds)+1 > size {
+		count = len(seeds) + 1
+	}
+	r := func1(st[0] ^ st[mid] ^ st[size-1])
+	st[mid] += r
+	r += uint32(len(seeds))
+	st[mid+lag] += r
+	st[0] = r
+	count--
+	i, j := 1, 0
+	for ; j < count; j++ {
+		r = func1(st[i] ^ st[(i+mid)%size] ^ st[(i+size-1)%size])
+		st[(i+mid)%size] += r
+		if j < len(seeds) {
+			r += uint32(seeds[j])
+		}
+		r += uint32(i)
+		st[(i+mid+lag)%size] += r
+		st[i] = r
+		i = (i + 1) % size
+	}
+	for j = 0; j < size; j++ {
+		r = func2(st[i] + st[(i+mid)%size] + st[(i+size-1)%size])
+		st[(i+mid)%size] ^= r
+		r -= uint32(i)
+		st[(i+mid+lag)%size] ^= r
+		st[i] = r
+		i = (i + 1) % size
+	}
+	s.setState(st)
+}
+
+func func1(x uint32) uint32 {
+	return (x ^ (x >> 27)) * 1664525
+}
+
+func func2(x uint32) uint32 {
+	return (x ^ (x >> 27)) * 1566083941
+}
+
+// init sets default parameters to zero-value Source.
+func (s *Source) init() {
+	if s.p == nil {
+		s.p = lookup(MEXP19937)
+		s.state = make([]uint64, s.p.n()*2)
+	}
+}
+
+// setState sets the state vector in 32-bit words, and certificates the period.
+func (s *Source) setState(st []uint32) {
+	for i := range s.state {
+		s.state[i] = uint64(st[2*i+1])<<32 | uint64(st[2*i])
+	}
+	s.idx = len(st)
+	s.certificatePeriod()
+}
+
+// certificatePeriod certificates the period of 2^MEXP-1 (period_certification function).
+func (s *Source) certificatePeriod() {
+	w := [4]uint32{uint32(s.state[0]), uint32(s.state[0] >> 32), uint32(s.state[1]), uint32(s.state[1] >> 32)}
+	inner := uint32(0)
+	for i := range w {
+		inner ^= w[i] & s.p.parity[i]
+	}
+	for i := 16; i > 0; i >>= 1 {
+		inner ^= inner >> i
+	}
+	if inner&1 == 1 {
+		return
+	}
+	for i := range w {
+		for work := uint32(1); work != 0; work <<= 1 {
+			if work&s.p.parity[i] != 0 {
+				s.state[i/2] ^= uint64(work) << (32 * (i % 2))
+				return
+			}
+		}
+	}
+}
+
+// Uint32 generates a random number on [0, 2^32-1]-interval
+func (s *Source) Uint32() uint32 {
+	if s == nil {
+		return 0
+	}
+	if s.idx >= len(s.state)*2 {
+		if s.p == nil {
+			s.Seed(1234) // a default initial seed is used
+		}
+		s.generateAll()
+		s.idx = 0
+	}
+	w := s.state[s.idx/2]
+	if s.idx&1 != 0 {
+		w >>= 32
+	}
+	s.idx++
+	return uint32(w)
+}
+
+// Uint64 generates a random number on [0, 2^64-1]-interval.
+// It is composed of two Uint32 values; the first one is the lower 32 bits
+// (same as sfmt_genrand_uint64 function, if the number of preceding Uint32 calls is even).
+func (s *Source) Uint64() uint64 {
+	if s == nil {
+		return 0
+	}
+	if s.idx&1 == 0 && s.idx < len(s.state)*2 {
+		w := s.state[s.idx/2]
+		s.idx += 2
+		return w
+	}
+	lo := s.Uint32()
+	return uint64(s.Uint32())<<32 | uint64(lo)
+}
+
+// Real generates a random number
+// on [0,1]-real-interval if mode==1,
+// on [0,1)-real-interval if mode==2,
+// on (0,1)-real-interval others
+//
+// Deprecated: use Source.Float64 method instead.
+func (s *Source) Real(mode int) float64 {
+	return s.Float64(mt.ModeInterval(mode))
+}
+
+// Float64 generates a random number on the interval iv.
+// Float64(mt.ClosedOpen) is same as sfmt_genrand_res53 function.
+func (s *Source) Float64(iv mt.Interval) float64 {
+	if s == nil {
+		return 0.0
+	}
+	return iv.Float64(s.Uint64())
+}
+
+// FillUint32s fills buf with random numbers (same as Uint32 method called len(buf) times).
+func (s *Source) FillUint32s(buf []uint32) {
+	if s == nil {
+		return
+	}
+	for len(buf) > 0 {
+		if s.idx&1 != 0 || len(buf) == 1 {
+			buf[0] = s.Uint32()
+			buf = buf[1:]
+			continue
+		}
+		if s.idx >= len(s.state)*2 {
+			if s.p == nil {
+				s.Seed(1234) // a default initial seed is used
+			}
+			s.generateAll()
+			s.idx = 0
+		}
+		st := s.state[s.idx/2:]
+		st = st[:min(len(st), len(buf)/2)]
+		for j, w := range st {
+			buf[2*j], buf[2*j+1] = uint32(w), uint32(w>>32)
+		}
+		s.idx += 2 * len(st)
+		buf = buf[2*len(st):]
+	}
+}
+
+// FillUint64s fills buf with random numbers (same as Uint64 method called len(buf) times).
+// If the internal state has been consumed and len(buf) >= MinArraySize(), random numbers are generated
+// directly into buf in 128-bit words (same as sfmt_fill_array64 function).
+func (s *Source) FillUint64s(buf []uint64) {
+	if s == nil {
+		return
+	}
+	for len(buf) > 0 && s.idx < len(s.state)*2 {
+		buf[0] = s.Uint64()
+		buf = buf[1:]
+	}
+	if n := len(buf) &^ 1; n > 0 && n >= len(s.state) {
+		if s.p == nil {
+			s.Seed(1234) // a default initial seed is used
+		}
+		s.generateArray(buf[:n])
+		buf = buf[n:]
+	}
+	for i := range buf {
+		buf[i] = s.Uint64()
+	}
+}
+
+// generateAll regenerates the whole state vector (gen_rand_all function).
+func (s *Source) generateAll() {
+	k, st := s.p.kernel(), s.state
+	n, pos1 := len(st)/2, s.p.pos1
+	c0, c1 := st[2*n-4], st[2*n-3]
+	d0, d1 := st[2*n-2], st[2*n-1]
+	for i := 0; i < n; i++ {
+		j := i + pos1
+		if j >= n {
+			j -= n
+		}
+		r0, r1 := k.recursion(st[2*i:2*i+2:2*i+2], st[2*j:2*j+2:2*j+2], c0, c1, d0, d1)
+		st[2*i], st[2*i+1] = r0, r1
+		c0, c1, d0, d1 = d0, d1, r0, r1
+	}
+}
+
+// generateArray generates random numbers into array directly, and updates the state vector
+// (gen_rand_array function). len(array) must be even and not less than len(s.state).
+func (s *Source) generateArray(array []uint64) {
+	k, st := s.p.kernel(), s.state
+	n, size, pos1 := len(st)/2, len(array)/2, s.p.pos1
+	c0, c1 := st[2*n-4], st[2*n-3]
+	d0, d1 := st[2*n-2], st[2*n-1]
+	i := 0
+	for ; i < n-pos1; i++ {
+		j := i + pos1
+		r0, r1 := k.recursion(st[2*i:2*i+2:2*i+2], st[2*j:2*j+2:2*j+2], c0, c1, d0, d1)
+		array[2*i], array[2*i+1] = r0, r1
+		c0, c1, d0, d1 = d0, d1, r0, r1
+	}
+	for ; i < n; i++ {
+		j := i + pos1 - n
+		r0, r1 := k.recursion(st[2*i:2*i+2:2*i+2], array[2*j:2*j+2:2*j+2], c0, c1, d0, d1)
+		array[2*i], array[2*i+1] = r0, r1
+		c0, c1, d0, d1 = d0, d1, r0, r1
+	}
+	for ; i < size; i++ {
+		h, j := i-n, i+pos1-n
+		r0, r1 := k.recursion(array[2*h:2*h+2:2*h+2], array[2*j:2*j+2:2*j+2], c0, c1, d0, d1)
+		array[2*i], array[2*i+1] = r0, r1
+		c0, c1, d0, d1 = d0, d1, r0, r1
+	}
+	copy(st, array[2*(size-n):2*size])
+	s.idx = len(st) * 2
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,218 @@
+package sfmt
+
+import (
+	"errors"
+	"testing"
+)
+
+// first outputs of SFMT.19937.out.txt (reference output of SFMT by the original authors):
+// sfmt_init_gen_rand(1234) and sfmt_init_by_array({0x1234, 0x5678, 0x9abc, 0xdef0})
+var (
+	referenceInitGenRand = []uint32{
+		3440181298, 1564997079, 1510669302, 2930277156, 1452439940,
+		3796268453, 423124208, 2143818589, 3827219408, 2987036003,
+		2674978610, 1536842514, 2027035537, 2534897563, 1686527725,
+		545368292, 1489013321, 1370534252, 4231012796, 3994803019,
+		1764869045, 824597505, 862581900, 2469764249, 812862514,
+		359318673, 116957936, 3367389672, 2327178354, 1898245200,
+		3206507879, 2378925033, 1040214787, 2524778605, 3088428700,
+		1417665896, 964324147, 2282797708, 2456269299, 313400376,
+		2245093271, 1015729427, 2694465011, 3246975184, 1992793635,
+		463679346, 3721104591, 3475064196, 856141236, 1499559719,
+	}
+	referenceInitByArray = []uint32{
+		2920711183, 3885745737, 3501893680, 856470934, 1421864068,
+		277361036, 1518638004, 2328404353, 3355513634, 64329189,
+		1624587673, 3508467182, 2481792141, 3706480799, 1925859037,
+		2913275699, 882658412, 384641219, 422202002, 1873384891,
+		2006084383, 3924929912, 1636718106, 3108838742, 1245465724,
+		4195470535, 779207191, 1577721373, 1390469554, 2928648150,
+		121399709, 3170839019, 4044347501, 953953814, 3821710850,
+		3085591323, 3666535579, 3577837737, 2012008410, 3565417471,
+		4044408017, 433600965, 1637785608, 1798509764, 860770589,
+		3081466273, 3982393409, 2451928325, 3437124742, 4093828739,
+	}
+)
+
+func TestSFMT19937(t *testing.T) {
+	s := New(1234)
+	for i, res := range referenceInitGenRand {
+		if r := s.Uint32(); r != res {
+			t.Errorf("Source.Uint32() [%v] = %v, want %v.", i, r, res)
+		}
+	}
+	s = NewWithArray([]uint64{0x1234, 0x5678, 0x9abc, 0xdef0})
+	for i, res := range referenceInitByArray {
+		if r := s.Uint32(); r != res {
+			t.Errorf("Source.Uint32() [%v] = %v, want %v.", i, r, res)
+		}
+	}
+	if str := s.IDString(); str != "SFMT-19937:122-18-1-11-1:dfffffef-ddfecb7f-bffaffff-bffffff6" {
+		t.Errorf("Source.IDString() = %v, want %v.", str, "SFMT-19937:122-18-1-11-1:dfffffef-ddfecb7f-bffaffff-bffffff6")
+	}
+}
+
+func TestExponents(t *testing.T) {
+	// regression values except MEXP19937 (the parameter sets are verified by TestCharacteristicPolynomial)
+	testCases := []struct {
+		mexp Exponent
+		res  []uint32
+	}{
+		{mexp: MEXP607, res: []uint32{1196421539, 2865311212, 3866479472, 2692900087, 3838928621}},
+		{mexp: MEXP1279, res: []uint32{243307689, 3927268025, 1225611617, 570598983, 3842545525}},
+		{mexp: MEXP2281, res: []uint32{816899028, 2529810904, 2984700728, 4161010272, 3805350266}},
+		{mexp: MEXP4253, res: []uint32{2527479900, 1368357778, 2663671614, 1404435254, 2699472814}},
+		{mexp: MEXP11213, res: []uint32{553293926, 698755237, 2442073441, 4209880924, 1764362329}},
+		{mexp: MEXP19937, res: referenceInitGenRand[:5]},
+		{mexp: MEXP44497, res: []uint32{3668471065, 3938124162, 4226228648, 1183164762, 959305109}},
+		{mexp: MEXP86243, res: []uint32{729010956, 4245516629, 2851064434, 363057815, 4150273260}},
+		{mexp: MEXP132049, res: []uint32{3596981943, 2237974425, 3827224957, 2514757895, 4264843680}},
+		{mexp: MEXP216091, res: []uint32{1905350899, 752275649, 2172726721, 1382267163, 3279518050}},
+	}
+	for _, tc := range testCases {
+		s, err := NewExp(tc.mexp, 1234)
+		if err != nil {
+			t.Errorf("NewExp(%v) is \"%v\", want <nil>.", tc.mexp, err)
+			continue
+		}
+		if e := s.Exponent(); e != tc.mexp {
+			t.Errorf("Source.Exponent() = %v, want %v.", e, tc.mexp)
+		}
+		if n := s.MinArraySize(); n != (int(tc.mexp)/128+1)*2 {
+			t.Errorf("Source.MinArraySize() = %v, want %v.", n, (int(tc.mexp)/128+1)*2)
+		}
+		for i, res := range tc.res {
+			if r := s.Uint32(); r != res {
+				t.Errorf("Source.Uint32() (%v) [%v] = %v, want %v.", tc.mexp, i, r, res)
+			}
+		}
+	}
+	if _, err := NewExp(19938, 1234); !errors.Is(err, ErrUnsupportedExponent) {
+		t.Errorf("NewExp() is \"%v\", want \"%v\".", err, ErrUnsupportedExponent)
+	}
+	if _, err := NewExpWithArray(0, nil); !errors.Is(err, ErrUnsupportedExponent) {
+		t.Errorf("NewExpWithArray() is \"%v\", want \"%v\".", err, ErrUnsupportedExponent)
+	}
+}
+
+func TestUint64(t *testing.T) {
+	s1, s2 := New(4321), New(4321)
+	for i := 0; i < 1000; i++ {
+		if i == 500 {
+			s1.Uint32()
+			s2.Uint32()
+		}
+		lo := uint64(s2.Uint32())
+		res := uint64(s2.Uint32())<<32 | lo
+		if r := s1.Uint64(); r != res {
+			t.Errorf("Source.Uint64() [%v] = %v, want %v.", i, r, res)
+		}
+	}
+}
+
+func TestFill(t *testing.T) {
+	for _, mexp := range []Exponent{MEXP607, MEXP19937, MEXP216091} {
+		for _, skip := range []int{0, 1, 2, 5} {
+			for _, size := range []int{0, 1, 7, 40, 312, 313, 1000, 5001} {
+				s1, _ := NewExp(mexp, 5489)
+				s2, _ := NewExp(mexp, 5489)
+				for i := 0; i < skip; i++ {
+					s1.Uint32()
+					s2.Uint32()
+				}
+				buf64 := make([]uint64, size)
+				s1.FillUint64s(buf64)
+				for i, r := range buf64 {
+					if res := s2.Uint64(); r != res {
+						t.Fatalf("Source.FillUint64s() (%v, skip %v, size %v) [%v] = %v, want %v.", mexp, skip, size, i, r, res)
+					}
+				}
+				buf32 := make([]uint32, size)
+				s1.FillUint32s(buf32)
+				for i, r := range buf32 {
+					if res := s2.Uint32(); r != res {
+						t.Fatalf("Source.FillUint32s() (%v, skip %v, size %v) [%v] = %v, want %v.", mexp, skip, size, i, r, res)
+					}
+				}
+				if r, res := s1.Uint64(), s2.Uint64(); r != res {
+					t.Errorf("Source.Uint64() after filling (%v, skip %v, size %v) = %v, want %v.", mexp, skip, size, r, res)
+				}
+			}
+		}
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	s1, s2, s3 := &Source{}, &Source{}, &Source{}
+	buf := make([]uint64, 1000)
+	s2.FillUint64s(buf)
+	buf32 := make([]uint32, 2)
+	s3.FillUint32s(buf32)
+	res := New(1234) // a default initial seed is used
+	for i := range buf {
+		want := res.Uint64()
+		if r := s1.Uint64(); r != want {
+			t.Errorf("<empty>.Uint64() [%v] = \"%v\", want \"%v\".", i, r, want)
+		}
+		if buf[i] != want {
+			t.Errorf("<empty>.FillUint64s() [%v] = \"%v\", want \"%v\".", i, buf[i], want)
+		}
+		if i == 0 && uint64(buf32[1])<<32|uint64(buf32[0]) != want {
+			t.Errorf("<empty>.FillUint32s() = \"%v\", want \"%v\".", buf32, want)
+		}
+	}
+	s := &Source{}
+	s.SeedArray([]uint64{0x1234, 0x5678, 0x9abc, 0xdef0})
+	if r := s.Uint32(); r != referenceInitByArray[0] {
+		t.Errorf("<empty>.Uint32() = \"%v\", want \"%v\".", r, referenceInitByArray[0])
+	}
+}
+
+func TestNil(t *testing.T) {
+	s := (*Source)(nil)
+	s.Seed(1)
+	s.SeedArray(nil)
+	s.FillUint32s(make([]uint32, 1))
+	s.FillUint64s(make([]uint64, 1))
+	if r := s.Uint32(); r != 0 {
+		t.Errorf("<nil>.Uint32() = \"%v\", want \"%v\".", r, 0)
+	}
+	if r := s.Uint64(); r != 0 {
+		t.Errorf("<nil>.Uint64() = \"%v\", want \"%v\".", r, 0)
+	}
+	if r := s.Real(0); r != 0 {
+		t.Errorf("<nil>.Real() = \"%v\", want \"%v\".", r, 0)
+	}
+	if e := s.Exponent(); e != 0 {
+		t.Errorf("<nil>.Exponent() = \"%v\", want \"%v\".", e, 0)
+	}
+	if str := s.IDString(); str != "" {
+		t.Errorf("<nil>.IDString() = \"%v\", want \"%v\".", str, "")
+	}
+	if n := s.MinArraySize(); n != 0 {
+		t.Errorf("<nil>.MinArraySize() = \"%v\", want \"%v\".", n, 0)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,121 @@
+package mt
+
+import (
+	"math/rand/v2"
+	"sync"
+)
+
+// shard is a Source with its own lock (padded to avoid false sharing).
+type shard struct {
+	mutex  sync.Mutex
+	source Source
+	_      [64]byte
+}
+
+// ShardedPRNG is class of concurrency-safe pseudo random number generator with multiple sources (shards).
+// Each call picks an unlocked shard, so goroutines rarely wait for each other.
+// Each shard generates deterministic sequence,
+// but the order of values across goroutines depends on scheduling.
+type ShardedPRNG struct {
+	shards []shard
+}
+
+var _ rand.Source = (*ShardedPRNG)(nil) //ShardedPRNG is compatible with rand.Source interface
+
+// NewSharded returns new ShardedPRNG instance with sources.
+// Sources should generate non-overlapping sequences (e.g. by jump-ahead).
+func NewSharded(sources ...Source) *ShardedPRNG {
+	shards := make([]shard, 0, len(sources))
+	for _, s := range sources {
+		if s != nil {
+			shards = append(shards, shard{source: s})
+		}
+	}
+	return &ShardedPRNG{shards: shards}
+}
+
+// Shards returns the number of shards.
+func (prng *ShardedPRNG) Shards() int {
+	if prng == nil {
+		return 0
+	}
+	return len(prng.shards)
+}
+
+// lock picks and locks a shard.
+// It tries shards from random position without blocking, and waits on the first one if all shards are locked.
+func (prng *ShardedPRNG) lock() *shard {
+	n := len(prng.shards)
+	start := int(uint64(rand.Uint32()) * uint64(n) >> 32) // lock-free runtime random
+	for i := 0; i < n; i++ {
+		j := start + i
+		if j >= n {
+			j -= n
+		}
+		if sh := &prng.shards[j]; sh.mutex.TryLock() {
+			return sh
+		}
+	}
+	sh := &prng.shards[start]
+	sh.mutex.Lock()
+	return sh
+}
+
+// Uint64 generates a random number on [0, 2^64-1]-interval
+func (prng *ShardedPRNG) Uint64() (n uint64) {
+	if prng == nil || len(prng.shards) == 0 {
+		return 0
+	}
+	sh := prng.lock()
+	n = sh.source.Uint64()
+	sh.mutex.Unlock()
+	return
+}
+
+// Real generates a random number (see Source.Real method)
+//
+// Deprecated: use ShardedPRNG.Float64 method instead.
+func (prng *ShardedPRNG) Real(mode int) (f float64) {
+	if prng == nil || len(prng.shards) == 0 {
+		return 0
+	}
+	sh := prng.lock()
+	f = sh.source.Real(mode)
+	sh.mutex.Unlock()
+	return
+}
+
+// Float64 generates a random number on the interval iv.
+// If the source has Float64(Interval) method (e.g. *dsfmt.Source), it is used.
+func (prng *ShardedPRNG) Float64(iv Interval) (f float64) {
+	if prng == nil || len(prng.shards) == 0 {
+		return 0
+	}
+	sh := prng.lock()
+	f = float64Of(sh.source, iv)
+	sh.mutex.Unlock()
+	return
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
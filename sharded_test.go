@@ -0,0 +1,104 @@
+package mt
+
+import (
+	"sync"
+	"testing"
+)
+
+// mockup for test
+type countSource struct {
+	id, ct uint64
+}
+
+func (s *countSource) SeedArray(seeds []uint64) {}
+func (s *countSource) Uint64() uint64           { s.ct++; return s.id<<32 | s.ct }
+func (s *countSource) Real(mode int) float64    { return float64(s.id) }
+
+func TestShardedPRNG(t *testing.T) {
+	sources := []Source{&countSource{id: 1}, nil, &countSource{id: 2}, &countSource{id: 3}}
+	prng := NewSharded(sources...)
+	if n := prng.Shards(); n != 3 {
+		t.Errorf("ShardedPRNG.Shards() = %v, want %v.", n, 3)
+	}
+	wg := sync.WaitGroup{}
+	mutex := sync.Mutex{}
+	values := map[uint64]int{}
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			vs := make([]uint64, 1000)
+			for j := range vs {
+				vs[j] = prng.Uint64()
+			}
+			mutex.Lock()
+			for _, v := range vs {
+				values[v]++
+			}
+			mutex.Unlock()
+		}()
+	}
+	wg.Wait()
+	total := uint64(0)
+	for _, s := range sources {
+		if s == nil {
+			continue
+		}
+		cs := s.(*countSource)
+		for k := uint64(1); k <= cs.ct; k++ {
+			if ct := values[cs.id<<32|k]; ct != 1 {
+				t.Errorf("value %x is generated %v times, want %v.", cs.id<<32|k, ct, 1)
+			}
+		}
+		total += cs.ct
+	}
+	if total != 100000 || len(values) != 100000 {
+		t.Errorf("ShardedPRNG.Uint64() is called %v times (%v values), want %v.", total, len(values), 100000)
+	}
+	if f := prng.Real(0); f != 1 && f != 2 && f != 3 {
+		t.Errorf("ShardedPRNG.Real() = %v, want 1, 2 or 3.", f)
+	}
+	if f := prng.Float64(ClosedOpen); f < 0 || f >= 1 {
+		t.Errorf("ShardedPRNG.Float64() = %v, want [0,1).", f)
+	}
+}
+
+func TestShardedPRNGNil(t *testing.T) {
+	for _, prng := range []*ShardedPRNG{nil, NewSharded()} {
+		if n := prng.Uint64(); n != 0 {
+			t.Errorf("ShardedPRNG.Uint64() = %v, want %v.", n, 0)
+		}
+		if f := prng.Real(0); f != 0 {
+			t.Errorf("ShardedPRNG.Real() = %v, want %v.", f, 0)
+		}
+		if f := prng.Float64(Closed); f != 0 {
+			t.Errorf("ShardedPRNG.Float64() = %v, want %v.", f, 0)
+		}
+		if n := prng.Shards(); n != 0 {
+			t.Errorf("ShardedPRNG.Shards() = %v, want %v.", n, 0)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
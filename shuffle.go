@@ -0,0 +1,152 @@
+package mt
+
+import "math/rand/v2"
+
+// Shuffle pseudo-randomizes the order of elements by Fisher-Yates shuffle under a single lock.
+// n is the number of elements, and swap swaps the elements with indexes i and j.
+// swap must not call methods of prng (it causes a deadlock).
+// It panics if n < 0.
+func (prng *PRNG) Shuffle(n int, swap func(i, j int)) {
+	if n < 0 {
+		panic("invalid argument to Shuffle")
+	}
+	if prng == nil {
+		return
+	}
+	prng.mutex.Lock()
+	defer prng.mutex.Unlock()
+	shuffle(prng.source, n, swap)
+}
+
+// Perm returns a pseudo-random permutation of the integers [0, n-1] under a single lock.
+// It panics if n < 0.
+func (prng *PRNG) Perm(n int) []int {
+	if n < 0 {
+		panic("invalid argument to Perm")
+	}
+	if prng == nil {
+		return nil
+	}
+	prng.mutex.Lock()
+	defer prng.mutex.Unlock()
+	return perm(prng.source, n)
+}
+
+// Sample returns k distinct integers chosen from [0, n-1] pseudo-randomly, in random order,
+// under a single lock.
+// It panics if k < 0 or k > n.
+func (prng *PRNG) Sample(n, k int) []int {
+	if k < 0 || k > n {
+		panic("invalid argument to Sample")
+	}
+	if prng == nil {
+		return nil
+	}
+	prng.mutex.Lock()
+	defer prng.mutex.Unlock()
+	return sample(prng.source, n, k)
+}
+
+// ShuffleSlice pseudo-randomizes the order of elements of s from src.
+// If src has Shuffle method (e.g. *PRNG), it is used (the lock is held only once).
+func ShuffleSlice[T any](src rand.Source, s []T) {
+	swap := func(i, j int) { s[i], s[j] = s[j], s[i] }
+	if r, ok := src.(interface{ Shuffle(int, func(int, int)) }); ok {
+		r.Shuffle(len(s), swap)
+		return
+	}
+	shuffle(src, len(s), swap)
+}
+
+// Choice returns an element of s chosen pseudo-randomly from src.
+// It panics if s is empty.
+func Choice[T any](src rand.Source, s []T) T {
+	if len(s) == 0 {
+		panic("invalid argument to Choice")
+	}
+	return s[N(src, len(s))]
+}
+
+// SampleWithoutReplacement returns k elements of s chosen pseudo-randomly from src without replacement,
+// in random order (a new slice is returned, and s is not changed).
+// If src has Sample method (e.g. *PRNG), it is used (the lock is held only once).
+// It panics if k < 0 or k > len(s).
+func SampleWithoutReplacement[T any](src rand.Source, s []T, k int) []T {
+	if k < 0 || k > len(s) {
+		panic("invalid argument to SampleWithoutReplacement")
+	}
+	var idx []int
+	if r, ok := src.(interface{ Sample(int, int) []int }); ok {
+		idx = r.Sample(len(s), k)
+	} else {
+		idx = sample(src, len(s), k)
+	}
+	if idx == nil {
+		return nil
+	}
+	res := make([]T, len(idx))
+	for i, j := range idx {
+		res[i] = s[j]
+	}
+	return res
+}
+
+// shuffle is Fisher-Yates shuffle (the same as Shuffle method of math/rand/v2.Rand
+// except for generating random integers).
+func shuffle(src rand.Source, n int, swap func(i, j int)) {
+	for i := n - 1; i > 0; i-- {
+		swap(i, int(uint64n(src, uint64(i+1))))
+	}
+}
+
+// perm returns a pseudo-random permutation of the integers [0, n-1].
+func perm(src rand.Source, n int) []int {
+	p := make([]int, n)
+	for i := range p {
+		p[i] = i
+	}
+	shuffle(src, n, func(i, j int) { p[i], p[j] = p[j], p[i] })
+	return p
+}
+
+// sample returns k distinct integers chosen from [0, n-1] by partial Fisher-Yates shuffle
+// (the first k steps, swapped indexes are kept in a map).
+func sample(src rand.Source, n, k int) []int {
+	res := make([]int, k)
+	swapped := map[int]int{}
+	at := func(i int) int {
+		if v, ok := swapped[i]; ok {
+			return v
+		}
+		return i
+	}
+	for i := 0; i < k; i++ {
+		j := i + int(uint64n(src, uint64(n-i)))
+		res[i] = at(j)
+		swapped[j] = at(i)
+	}
+	return res
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,209 @@
+package mt
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestShuffle(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 10, 1000} {
+		s1 := make([]int, n)
+		s2 := make([]int, n)
+		for i := range s1 {
+			s1[i], s2[i] = i, i
+		}
+		prng := New(&splitMix{x: 1})
+		prng.Shuffle(n, func(i, j int) { s1[i], s1[j] = s1[j], s1[i] })
+		res := New(&splitMix{x: 1})
+		for i := n - 1; i > 0; i-- {
+			j := res.IntN(i + 1)
+			s2[i], s2[j] = s2[j], s2[i]
+		}
+		if !slices.Equal(s1, s2) {
+			t.Errorf("PRNG.Shuffle(%v) = %v, want %v.", n, s1, s2)
+		}
+		if r, res := prng.Uint64(), res.Uint64(); r != res {
+			t.Errorf("PRNG.Uint64() after Shuffle(%v) = %v, want %v.", n, r, res)
+		}
+		if p := New(&splitMix{x: 1}).Perm(n); !slices.Equal(p, s1) {
+			t.Errorf("PRNG.Perm(%v) = %v, want %v.", n, p, s1)
+		}
+		s3 := make([]int, n)
+		for i := range s3 {
+			s3[i] = i
+		}
+		ShuffleSlice(&splitMix{x: 1}, s3)
+		if !slices.Equal(s3, s1) {
+			t.Errorf("ShuffleSlice(%v) = %v, want %v.", n, s3, s1)
+		}
+	}
+}
+
+func TestPerm(t *testing.T) {
+	prng := New(&splitMix{x: 1})
+	p := prng.Perm(1000)
+	slices.Sort(p)
+	for i, v := range p {
+		if i != v {
+			t.Errorf("PRNG.Perm() is not a permutation: %v-th value = %v.", i, v)
+			break
+		}
+	}
+}
+
+func TestSample(t *testing.T) {
+	for _, tc := range []struct{ n, k int }{{0, 0}, {1, 1}, {10, 0}, {10, 3}, {10, 10}, {1000, 100}} {
+		idx := New(&splitMix{x: 1}).Sample(tc.n, tc.k)
+		if len(idx) != tc.k {
+			t.Errorf("PRNG.Sample(%v, %v) has %v elements, want %v.", tc.n, tc.k, len(idx), tc.k)
+			continue
+		}
+		seen := map[int]bool{}
+		for _, v := range idx {
+			if v < 0 || v >= tc.n || seen[v] {
+				t.Errorf("PRNG.Sample(%v, %v) = %v, has invalid or duplicate value %v.", tc.n, tc.k, idx, v)
+				break
+			}
+			seen[v] = true
+		}
+		// the same as the first k elements of Fisher-Yates shuffle from index 0
+		p := make([]int, tc.n)
+		for i := range p {
+			p[i] = i
+		}
+		res := New(&splitMix{x: 1})
+		for i := 0; i < tc.k; i++ {
+			j := i + res.IntN(tc.n-i)
+			p[i], p[j] = p[j], p[i]
+		}
+		if !slices.Equal(idx, p[:tc.k]) {
+			t.Errorf("PRNG.Sample(%v, %v) = %v, want %v.", tc.n, tc.k, idx, p[:tc.k])
+		}
+	}
+}
+
+func TestSampleWithoutReplacement(t *testing.T) {
+	s := []string{"a", "b", "c", "d", "e", "f", "g"}
+	for k := 0; k <= len(s); k++ {
+		idx := New(&splitMix{x: 1}).Sample(len(s), k)
+		res := make([]string, len(idx))
+		for i, j := range idx {
+			res[i] = s[j]
+		}
+		if r := SampleWithoutReplacement(New(&splitMix{x: 1}), s, k); !slices.Equal(r, res) {
+			t.Errorf("SampleWithoutReplacement(PRNG, %v) = %v, want %v.", k, r, res)
+		}
+		if r := SampleWithoutReplacement(&splitMix{x: 1}, s, k); !slices.Equal(r, res) {
+			t.Errorf("SampleWithoutReplacement(Source, %v) = %v, want %v.", k, r, res)
+		}
+	}
+	if !slices.Equal(s, []string{"a", "b", "c", "d", "e", "f", "g"}) {
+		t.Errorf("SampleWithoutReplacement() changes s: %v.", s)
+	}
+}
+
+func TestChoice(t *testing.T) {
+	s := []string{"a", "b", "c", "d", "e", "f", "g"}
+	prng := New(&splitMix{x: 1})
+	res := New(&splitMix{x: 1})
+	for i := 0; i < 100; i++ {
+		if r, w := Choice(prng, s), s[res.IntN(len(s))]; r != w {
+			t.Errorf("Choice() = %v, want %v.", r, w)
+		}
+	}
+}
+
+func TestShuffleConcurrency(t *testing.T) {
+	// draws of a shuffle are not interleaved with draws of other goroutines
+	src := &countSource{id: 1}
+	prng := New(src)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				_ = prng.Uint64()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			start := uint64(0)
+			prng.Shuffle(100, func(i, j int) {
+				if i == 99 {
+					start = src.ct
+				}
+				if src.ct != start+uint64(99-i) {
+					t.Errorf("PRNG.Shuffle() draws %v random numbers at step %v, want %v.", src.ct-start, 99-i, 99-i)
+				}
+			})
+		}()
+	}
+	wg.Wait()
+}
+
+func TestShufflePanic(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func()
+	}{
+		{name: "Shuffle", f: func() { New(&splitMix{}).Shuffle(-1, func(i, j int) {}) }},
+		{name: "Perm", f: func() { New(&splitMix{}).Perm(-1) }},
+		{name: "Sample", f: func() { New(&splitMix{}).Sample(3, 4) }},
+		{name: "Choice", f: func() { Choice(New(&splitMix{}), []int{}) }},
+		{name: "SampleWithoutReplacement", f: func() { SampleWithoutReplacement(rand.NewPCG(1, 2), []int{1}, -1) }},
+	}
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%v() does not panic.", tc.name)
+				}
+			}()
+			tc.f()
+		}()
+	}
+}
+
+func TestShuffleNil(t *testing.T) {
+	prng := (*PRNG)(nil)
+	called := false
+	prng.Shuffle(10, func(i, j int) { called = true })
+	if called {
+		t.Error("<nil>.Shuffle() calls swap.")
+	}
+	if p := prng.Perm(10); p != nil {
+		t.Errorf("<nil>.Perm() = %v, want nil.", p)
+	}
+	if p := prng.Sample(10, 3); p != nil {
+		t.Errorf("<nil>.Sample() = %v, want nil.", p)
+	}
+	if p := SampleWithoutReplacement(prng, []int{1, 2, 3}, 2); p != nil {
+		t.Errorf("SampleWithoutReplacement(<nil>) = %v, want nil.", p)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
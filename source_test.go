@@ -0,0 +1,134 @@
+package mt_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/goark/mt/v2"
+	"github.com/goark/mt/v2/dsfmt"
+	"github.com/goark/mt/v2/mt19937"
+	"github.com/goark/mt/v2/mt19937ar"
+	"github.com/goark/mt/v2/sfmt"
+	"github.com/goark/mt/v2/tinymt32"
+	"github.com/goark/mt/v2/tinymt64"
+)
+
+// floatSource is a source with native Float64 method
+type floatSource interface {
+	mt.Source
+	Float64(mt.Interval) float64
+}
+
+var sources = []struct {
+	name string
+	new  func() floatSource
+	real bool //Real(mode) is the same as Float64(mt.ModeInterval(mode))
+}{
+	{name: "mt19937", new: func() floatSource { return mt19937.New(19650218) }, real: true},
+	{name: "mt19937ar", new: func() floatSource { return mt19937ar.New(19650218) }, real: false},
+	{name: "sfmt", new: func() floatSource { return sfmt.New(19650218) }, real: true},
+	{name: "dsfmt", new: func() floatSource { return dsfmt.New(19650218) }, real: true},
+	{name: "tinymt32", new: func() floatSource { return tinymt32.New(19650218) }, real: true},
+	{name: "tinymt64", new: func() floatSource { return tinymt64.New(19650218) }, real: true},
+}
+
+func TestPRNGFloat64Sources(t *testing.T) {
+	for _, tc := range sources {
+		for _, iv := range []mt.Interval{mt.Closed, mt.ClosedOpen, mt.OpenClosed, mt.Open} {
+			src := tc.new()
+			prng := mt.New(tc.new())
+			sharded := mt.NewSharded(tc.new())
+			locked := mt.NewLocked(tc.new())
+			for i := 0; i < 1000; i++ {
+				res := src.Float64(iv)
+				if f := prng.Float64(iv); f != res {
+					t.Errorf("PRNG.Float64(%v) with %v: %v-th value = %v, want %v.", iv, tc.name, i, f, res)
+					break
+				}
+				if f := sharded.Float64(iv); f != res {
+					t.Errorf("ShardedPRNG.Float64(%v) with %v: %v-th value = %v, want %v.", iv, tc.name, i, f, res)
+					break
+				}
+				if f := locked.Float64(iv); f != res {
+					t.Errorf("Locked.Float64(%v) with %v: %v-th value = %v, want %v.", iv, tc.name, i, f, res)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestPRNGRealSources(t *testing.T) {
+	for _, tc := range sources {
+		for mode := 0; mode < 3; mode++ {
+			src := tc.new()
+			prng1 := mt.New(tc.new())
+			prng2 := mt.New(tc.new())
+			for i := 0; i < 1000; i++ {
+				res := src.Real(mode)
+				if f := prng1.Real(mode); f != res {
+					t.Errorf("PRNG.Real(%v) with %v: %v-th value = %v, want %v.", mode, tc.name, i, f, res)
+					break
+				}
+				if f := prng2.Float64(mt.ModeInterval(mode)); tc.real && f != res {
+					t.Errorf("PRNG.Float64(ModeInterval(%v)) with %v: %v-th value = %v, want %v.", mode, tc.name, i, f, res)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestPRNGFillFloat64sSources(t *testing.T) {
+	for _, tc := range sources {
+		for _, iv := range []mt.Interval{mt.Closed, mt.ClosedOpen, mt.OpenClosed, mt.Open} {
+			src := tc.new()
+			prng := mt.New(tc.new())
+			buf := make([]float64, 2000)
+			prng.FillFloat64s(buf, iv)
+			for i, f := range buf {
+				if res := src.Float64(iv); f != res {
+					t.Errorf("PRNG.FillFloat64s(%v) with %v: %v-th value = %v, want %v.", iv, tc.name, i, f, res)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestPRNGFillFloat64sDSFMT(t *testing.T) {
+	for _, iv := range []mt.Interval{mt.Closed, mt.ClosedOpen, mt.OpenClosed, mt.Open} {
+		src := dsfmt.New(19650218)
+		prng := mt.New(dsfmt.New(19650218))
+		res := make([]float64, 2000) // len(buf) >= MinArraySize()
+		buf := make([]float64, 2000)
+		src.FillFloat64s(res, iv)
+		prng.FillFloat64s(buf, iv)
+		if !slices.Equal(buf, res) {
+			t.Errorf("PRNG.FillFloat64s(%v) with dsfmt is not the same as dsfmt.Source.FillFloat64s.", iv)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
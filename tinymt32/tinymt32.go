@@ -0,0 +1,259 @@
+package tinymt32
+
+import (
+	"github.com/goark/mt/v2"
+)
+
+const (
+	sh0     = 1
+	sh1     = 10
+	sh8     = 8
+	mask    = 0x7fffffff
+	minLoop = 8
+	preLoop = 8
+)
+
+// Params is a parameter set of TinyMT32 (generated by TinyMTDC).
+type Params struct {
+	Mat1 uint32
+	Mat2 uint32
+	Tmat uint32
+}
+
+// DefaultParams is the parameter set used in check vectors of the original TinyMT32.
+var DefaultParams = Params{Mat1: 0x8f7011ee, Mat2: 0xfc78ff1f, Tmat: 0x3793fdff}
+
+// Source is a source of random numbers (TinyMT32; 127-bit state).
+// Zero value of Source uses DefaultParams.
+type Source struct {
+	status [4]uint32
+	p      Params
+}
+
+var _ mt.Source = (*Source)(nil) //Source is compatible with mt.Source interface
+var _ mt.Seeder = (*Source)(nil) //Source is compatible with mt.Seeder interface
+
+// New returns a new pseudo-random source with DefaultParams seeded with the given value.
+// Only the lower 32 bits of seed are used (same as tinymt32_init function).
+func New(seed int64) *Source {
+	return DefaultParams.New(seed)
+}
+
+// NewWithArray returns a new pseudo-random source with DefaultParams seeded with the given values.
+// Only the lower 32 bits of each seed are used (same as tinymt32_init_by_array function).
+func NewWithArray(seeds []uint64) *Source {
+	return DefaultParams.NewWithArray(seeds)
+}
+
+// New returns a new pseudo-random source with the parameter set seeded with the given value.
+// If p is zero value, DefaultParams is used. Only the lower 32 bits of seed are used (same as tinymt32_init function).
+func (p Params) New(seed int64) *Source {
+	rng := &Source{p: p}
+	rng.Seed(seed)
+	return rng
+}
+
+// NewWithArray returns a new pseudo-random source with the parameter set seeded with the given values.
+// If p is zero value, DefaultParams is used. Only the lower 32 bits of each seed are used (same as tinymt32_init_by_array function).
+func (p Params) NewWithArray(seeds []uint64) *Source {
+	rng := &Source{p: p}
+	rng.SeedArray(seeds)
+	return rng
+}
+
+// Params returns the parameter set of Source.
+func (s *Source) Params() Params {
+	if s == nil {
+		return Params{}
+	}
+	return s.p
+}
+
+// Seed initializes Source with a seed
+func (s *Source) Seed(seed int64) {
+	if s == nil {
+		return
+	}
+	if s.p == (Params{}) {
+		s.p = DefaultParams
+	}
+	st := &s.status
+	st[0] = uint32(seed)
+	st[1] = s.p.Mat1
+	st[2] = s.p.Mat2
+	st[3] = s.p.Tmat
+	for i := uint32(1); i < minLoop; i++ {
+		st[i&3] ^= i + 1812433253*(st[(i-1)&3]^(st[(i-1)&3]>>30))
+	}
+	s.certificatePeriod()
+	for i := 0; i < preLoop; i++ {
+		s.nextState()
+	}
+}
+
+// SeedArray initializes Source with seeds array
+func (s *Source) SeedArray(seeds []uint64) {
+	if s == nil {
+		return
+	}
+	if s.p == (Params{}) {
+		s.p = DefaultParams
+	}
+	const (
+		lag  = 1
+		mid  = 1
+		size = 4
+	)
+	st := &s.status
+	st[0] = 0
+	st[1] = s.p.Mat1
+	st[2] = s.p.Mat2
+	st[3] = s.p.Tmat
+	count := minLoop
+	if len(seeds)+1 > minLoop {
+		count = len(seeds) + 1
+	}
+	r := func1(st[0] ^ st[mid%size] ^ st[(size-1)%size])
+	st[mid%size] += r
+	r += uint32(len(seeds))
+	st[(mid+lag)%size] += r
+	st[0] = r
+	count--
+	i := 1
+	for j := 0; j < count; j++ {
+		r = func1(st[i%size] ^ st[(i+mid)%size] ^ st[(i+size-1)%size])
+		st[(i+mid)%size] += r
+		if j < len(seeds) {
+			r += uint32(seeds[j])
+		}
+		r += uint32(i)
+		st[(i+mid+lag)%size] += r
+		st[i%size] = r
+		i = (i + 1) % size
+	}
+	for j := 0; j < size; j++ {
+		r = func2(st[i%size] + st[(i+mid)%size] + st[(i+size-1)%size])
+		st[(i+mid)%size] ^= r
+		r -= uint32(i)
+		st[(i+mid+lag)%size] ^= r
+		st[i%size] = r
+		i = (i + 1) % size
+	}
+	s.certificatePeriod()
+	for i := 0; i < preLoop; i++ {
+		s.nextState()
+	}
+}
+
+func func1(x uint32) uint32 {
+	return (x ^ (x >> 27)) * 1664525
+}
+
+func func2(x uint32) uint32 {
+	return (x ^ (x >> 27)) * 1566083941
+}
+
+// certificatePeriod avoids the all-zero state (period_certification function).
+func (s *Source) certificatePeriod() {
+	if s.status[0]&mask == 0 && s.status[1] == 0 && s.status[2] == 0 && s.status[3] == 0 {
+		s.status = [4]uint32{'T', 'I', 'N', 'Y'}
+	}
+}
+
+// nextState changes the internal state (tinymt32_next_state function).
+func (s *Source) nextState() {
+	st := &s.status
+	y := st[3]
+	x := (st[0] & mask) ^ st[1] ^ st[2]
+	x ^= x << sh0
+	y ^= (y >> sh0) ^ x
+	st[0] = st[1]
+	st[1] = st[2]
+	st[2] = x ^ (y << sh1)
+	st[3] = y
+	m := -(y & 1)
+	st[1] ^= m & s.p.Mat1
+	st[2] ^= m & s.p.Mat2
+}
+
+// temper outputs a tempered number from the internal state (tinymt32_temper function).
+func (s *Source) temper() uint32 {
+	t0 := s.status[3]
+	t1 := s.status[0] + (s.status[2] >> sh8)
+	t0 ^= t1
+	t0 ^= -(t1 & 1) & s.p.Tmat
+	return t0
+}
+
+// Uint32 generates a random number on [0, 2^32-1]-interval (tinymt32_generate_uint32 function)
+func (s *Source) Uint32() uint32 {
+	if s == nil {
+		return 0
+	}
+	if s.status == [4]uint32{} { // zero value of Source
+		s.Seed(5489) // a default initial seed is used
+	}
+	s.nextState()
+	return s.temper()
+}
+
+// Uint64 generates a random number on [0, 2^64-1]-interval.
+// It is composed of two Uint32 values; the first one is the upper 32 bits.
+func (s *Source) Uint64() uint64 {
+	if s == nil {
+		return 0
+	}
+	hi := uint64(s.Uint32())
+	return hi<<32 | uint64(s.Uint32())
+}
+
+// Real generates a random number
+// on [0,1]-real-interval if mode==1,
+// on [0,1)-real-interval if mode==2,
+// on (0,1)-real-interval others
+//
+// Deprecated: use Source.Float64 method instead.
+func (s *Source) Real(mode int) float64 {
+	return s.Float64(mt.ModeInterval(mode))
+}
+
+// Float64 generates a random number on the interval iv.
+// It uses two Uint32 values (see Uint64 method).
+func (s *Source) Float64(iv mt.Interval) float64 {
+	if s == nil {
+		return 0.0
+	}
+	return iv.Float64(s.Uint64())
+}
+
+// Float32 generates a random number (float32) on the interval iv.
+// Float32(mt.ClosedOpen) is same as tinymt32_generate_float function.
+func (s *Source) Float32(iv mt.Interval) float32 {
+	if s == nil {
+		return 0.0
+	}
+	return iv.Float32(uint64(s.Uint32()) << 32)
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,132 @@
+package tinymt32
+
+import (
+	"testing"
+
+	"github.com/goark/mt/v2"
+)
+
+// check vector of the original TinyMT32 (check32.out.txt; DefaultParams, seed = 1)
+var referenceUint32 = []uint32{
+	2545341989, 981918433, 3715302833, 2387538352, 3591001365,
+	3820442102, 2114400566, 2196103051, 2783359912, 764534509,
+	643179475, 1822416315, 881558334, 4207026366, 3690273640,
+	3240535687, 2921447122, 3984931427, 4092394160, 44209675,
+	2188315343, 2908663843, 1834519336, 3774670961, 3019990707,
+	4065554902, 1239765502, 4035716197, 3412127188, 552822483,
+	161364450, 353727785, 140085994, 149132008, 2547770827,
+	4064042525, 4078297538, 2057335507, 622384752, 2041665899,
+	2193913817, 1080849512, 33160901, 662956935, 642999063,
+	3384709977, 1723175122, 3866752252, 521822317, 2292524454,
+}
+
+func TestTinyMT32(t *testing.T) {
+	s := New(1)
+	for i, res := range referenceUint32 {
+		if r := s.Uint32(); r != res {
+			t.Errorf("Source.Uint32() [%v] = %v, want %v.", i, r, res)
+		}
+	}
+	s = DefaultParams.New(1)
+	for i, res := range referenceUint32 {
+		if r := s.Uint32(); r != res {
+			t.Errorf("Params.New().Uint32() [%v] = %v, want %v.", i, r, res)
+		}
+	}
+	if p := s.Params(); p != DefaultParams {
+		t.Errorf("Source.Params() = %v, want %v.", p, DefaultParams)
+	}
+}
+
+func TestSeedArray(t *testing.T) {
+	// regression values
+	res := []uint32{56890874, 895028026, 626205227, 491377950, 2651386131}
+	s := NewWithArray([]uint64{1})
+	for i, res := range res {
+		if r := s.Uint32(); r != res {
+			t.Errorf("Source.Uint32() [%v] = %v, want %v.", i, r, res)
+		}
+	}
+	s1, s2 := NewWithArray([]uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}), NewWithArray([]uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10 | 1<<32})
+	if r1, r2 := s1.Uint32(), s2.Uint32(); r1 != r2 {
+		t.Errorf("Source.Uint32() = %v, want %v (only lower 32 bits of seeds are used).", r2, r1)
+	}
+}
+
+func TestParams(t *testing.T) {
+	s1, s2 := New(1), Params{Mat1: DefaultParams.Mat1, Mat2: DefaultParams.Mat2, Tmat: 0}.New(1)
+	if r1, r2 := s1.Uint32(), s2.Uint32(); r1 == r2 {
+		t.Errorf("Source.Uint32() = %v with different parameter set, want different value.", r2)
+	}
+	if p := (Params{}).New(1).Params(); p != DefaultParams {
+		t.Errorf("Params.New().Params() = %v, want %v.", p, DefaultParams)
+	}
+}
+
+func TestFloat(t *testing.T) {
+	s1, s2 := New(1), New(1)
+	for i := 0; i < 100; i++ {
+		if f, res := s1.Float32(mt.ClosedOpen), float32(s2.Uint32()>>8)*(1.0/16777216.0); f != res {
+			t.Errorf("Source.Float32() [%v] = %v, want %v.", i, f, res)
+		}
+		if f, res := s1.Float64(mt.ClosedOpen), mt.ClosedOpen.Float64(s2.Uint64()); f != res {
+			t.Errorf("Source.Float64() [%v] = %v, want %v.", i, f, res)
+		}
+		if f, res := s1.Real(1), mt.Closed.Float64(s2.Uint64()); f != res {
+			t.Errorf("Source.Real() [%v] = %v, want %v.", i, f, res)
+		}
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	s, res := &Source{}, New(5489) // a default initial seed is used
+	for i := 0; i < 10; i++ {
+		if r, want := s.Uint32(), res.Uint32(); r != want {
+			t.Errorf("<empty>.Uint32() [%v] = \"%v\", want \"%v\".", i, r, want)
+		}
+	}
+}
+
+func TestNil(t *testing.T) {
+	s := (*Source)(nil)
+	s.Seed(1)
+	s.SeedArray(nil)
+	if r := s.Uint32(); r != 0 {
+		t.Errorf("<nil>.Uint32() = \"%v\", want \"%v\".", r, 0)
+	}
+	if r := s.Uint64(); r != 0 {
+		t.Errorf("<nil>.Uint64() = \"%v\", want \"%v\".", r, 0)
+	}
+	if r := s.Real(0); r != 0 {
+		t.Errorf("<nil>.Real() = \"%v\", want \"%v\".", r, 0)
+	}
+	if r := s.Float32(mt.Open); r != 0 {
+		t.Errorf("<nil>.Float32() = \"%v\", want \"%v\".", r, 0)
+	}
+	if p := s.Params(); p != (Params{}) {
+		t.Errorf("<nil>.Params() = \"%v\", want \"%v\".", p, Params{})
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
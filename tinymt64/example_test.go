@@ -0,0 +1,46 @@
+package tinymt64_test
+
+import (
+	"fmt"
+
+	"github.com/goark/mt/v2"
+	"github.com/goark/mt/v2/tinymt64"
+)
+
+func ExampleNew() {
+	fmt.Println(tinymt64.New(1).Uint64())
+	//Output:
+	//15503804787016557143
+}
+
+func ExampleParams_New() {
+	// parameter set generated by TinyMTDC (one set per entity)
+	params := tinymt64.Params{Mat1: 0xfa051f40, Mat2: 0xffd0fff4, Tmat: 0x58d02ffeffbfffbc}
+	prng := mt.New(params.New(1))
+	fmt.Println(prng.Uint64())
+	//Output:
+	//15503804787016557143
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,227 @@
+package tinymt64
+
+import (
+	"github.com/goark/mt/v2"
+)
+
+const (
+	sh0     = 12
+	sh1     = 11
+	sh8     = 8
+	mask    = 0x7fffffffffffffff
+	minLoop = 8
+)
+
+// Params is a parameter set of TinyMT64 (generated by TinyMTDC).
+type Params struct {
+	Mat1 uint32
+	Mat2 uint32
+	Tmat uint64
+}
+
+// DefaultParams is the parameter set used in check vectors of the original TinyMT64.
+var DefaultParams = Params{Mat1: 0xfa051f40, Mat2: 0xffd0fff4, Tmat: 0x58d02ffeffbfffbc}
+
+// Source is a source of random numbers (TinyMT64; 127-bit state).
+// Zero value of Source uses DefaultParams.
+type Source struct {
+	status [2]uint64
+	p      Params
+}
+
+var _ mt.Source = (*Source)(nil) //Source is compatible with mt.Source interface
+var _ mt.Seeder = (*Source)(nil) //Source is compatible with mt.Seeder interface
+
+// New returns a new pseudo-random source with DefaultParams seeded with the given value
+// (same as tinymt64_init function).
+func New(seed int64) *Source {
+	return DefaultParams.New(seed)
+}
+
+// NewWithArray returns a new pseudo-random source with DefaultParams seeded with the given values
+// (same as tinymt64_init_by_array function).
+func NewWithArray(seeds []uint64) *Source {
+	return DefaultParams.NewWithArray(seeds)
+}
+
+// New returns a new pseudo-random source with the parameter set seeded with the given value
+// (same as tinymt64_init function). If p is zero value, DefaultParams is used.
+func (p Params) New(seed int64) *Source {
+	rng := &Source{p: p}
+	rng.Seed(seed)
+	return rng
+}
+
+// NewWithArray returns a new pseudo-random source with the parameter set seeded with the given values
+// (same as tinymt64_init_by_array function). If p is zero value, DefaultParams is used.
+func (p Params) NewWithArray(seeds []uint64) *Source {
+	rng := &Source{p: p}
+	rng.SeedArray(seeds)
+	return rng
+}
+
+// Params returns the parameter set of Source.
+func (s *Source) Params() Params {
+	if s == nil {
+		return Params{}
+	}
+	return s.p
+}
+
+// Seed initializes Source with a seed
+func (s *Source) Seed(seed int64) {
+	if s == nil {
+		return
+	}
+	if s.p == (Params{}) {
+		s.p = DefaultParams
+	}
+	st := &s.status
+	st[0] = uint64(seed) ^ uint64(s.p.Mat1)<<32
+	st[1] = uint64(s.p.Mat2) ^ s.p.Tmat
+	for i := uint64(1); i < minLoop; i++ {
+		st[i&1] ^= i + 6364136223846793005*(st[(i-1)&1]^(st[(i-1)&1]>>62))
+	}
+	s.certificatePeriod()
+}
+
+// SeedArray initializes Source with seeds array
+func (s *Source) SeedArray(seeds []uint64) {
+	if s == nil {
+		return
+	}
+	if s.p == (Params{}) {
+		s.p = DefaultParams
+	}
+	const (
+		lag  = 1
+		mid  = 1
+		size = 4
+	)
+	st := [size]uint64{0, uint64(s.p.Mat1), uint64(s.p.Mat2), s.p.Tmat}
+	count := minLoop
+	if len(seeds)+1 > minLoop {
+		count = len(seeds) + 1
+	}
+	r := func1(st[0] ^ st[mid%size] ^ st[(size-1)%size])
+	st[mid%size] += r
+	r += uint64(len(seeds))
+	st[(mid+lag)%size] += r
+	st[0] = r
+	count--
+	i := 1
+	for j := 0; j < count; j++ {
+		r = func1(st[i%size] ^ st[(i+mid)%size] ^ st[(i+size-1)%size])
+		st[(i+mid)%size] += r
+		if j < len(seeds) {
+			r += seeds[j]
+		}
+		r += uint64(i)
+		st[(i+mid+lag)%size] += r
+		st[i%size] = r
+		i = (i + 1) % size
+	}
+	for j := 0; j < size; j++ {
+		r = func2(st[i%size] + st[(i+mid)%size] + st[(i+size-1)%size])
+		st[(i+mid)%size] ^= r
+		r -= uint64(i)
+		st[(i+mid+lag)%size] ^= r
+		st[i%size] = r
+		i = (i + 1) % size
+	}
+	s.status = [2]uint64{st[0] ^ st[1], st[2] ^ st[3]}
+	s.certificatePeriod()
+}
+
+func func1(x uint64) uint64 {
+	return (x ^ (x >> 59)) * 2173292883993
+}
+
+func func2(x uint64) uint64 {
+	return (x ^ (x >> 59)) * 58885565329898161
+}
+
+// certificatePeriod avoids the all-zero state (period_certification function).
+func (s *Source) certificatePeriod() {
+	if s.status[0]&mask == 0 && s.status[1] == 0 {
+		s.status = [2]uint64{'T', 'M'}
+	}
+}
+
+// nextState changes the internal state (tinymt64_next_state function).
+func (s *Source) nextState() {
+	st := &s.status
+	st[0] &= mask
+	x := st[0] ^ st[1]
+	x ^= x << sh0
+	x ^= x >> 32
+	x ^= x << 32
+	x ^= x << sh1
+	st[0] = st[1]
+	st[1] = x
+	m := -(x & 1)
+	st[0] ^= m & uint64(s.p.Mat1)
+	st[1] ^= m & (uint64(s.p.Mat2) << 32)
+}
+
+// temper outputs a tempered number from the internal state (tinymt64_temper function).
+func (s *Source) temper() uint64 {
+	x := s.status[0] + s.status[1]
+	x ^= s.status[0] >> sh8
+	x ^= -(x & 1) & s.p.Tmat
+	return x
+}
+
+// Uint64 generates a random number on [0, 2^64-1]-interval (tinymt64_generate_uint64 function)
+func (s *Source) Uint64() uint64 {
+	if s == nil {
+		return 0
+	}
+	if s.status == [2]uint64{} { // zero value of Source
+		s.Seed(5489) // a default initial seed is used
+	}
+	s.nextState()
+	return s.temper()
+}
+
+// Real generates a random number
+// on [0,1]-real-interval if mode==1,
+// on [0,1)-real-interval if mode==2,
+// on (0,1)-real-interval others
+//
+// Deprecated: use Source.Float64 method instead.
+func (s *Source) Real(mode int) float64 {
+	return s.Float64(mt.ModeInterval(mode))
+}
+
+// Float64 generates a random number on the interval iv.
+// Float64(mt.ClosedOpen) is same as tinymt64_generate_double function.
+func (s *Source) Float64(iv mt.Interval) float64 {
+	if s == nil {
+		return 0.0
+	}
+	return iv.Float64(s.Uint64())
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,124 @@
+package tinymt64
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/goark/mt/v2"
+)
+
+// check vector of the original TinyMT64 (check64.out.txt; DefaultParams, seed = 1)
+var referenceUint64 = []uint64{
+	15503804787016557143, 17280942441431881838, 2177846447079362065,
+	10087979609567186558, 8925138365609588954, 13030236470185662861,
+	4821755207395923002, 11414418928600017220, 18168456707151075513,
+	1749899882787913913, 2383809859898491614, 4819668342796295952,
+	11996915412652201592, 11312565842793520524, 995000466268691999,
+	6363016470553061398, 7460106683467501926, 981478760989475592,
+	11852898451934348777, 5976355772385089998, 16662491692959689977,
+	4997134580858653476, 11142084553658001518, 12405136656253403414,
+	10700258834832712655, 13440132573874649640, 15190104899818839732,
+	14179849157427519166, 10328306841423370385, 9266343271776906817,
+}
+
+func TestTinyMT64(t *testing.T) {
+	s := New(1)
+	for i, res := range referenceUint64 {
+		if r := s.Uint64(); r != res {
+			t.Errorf("Source.Uint64() [%v] = %v, want %v.", i, r, res)
+		}
+	}
+	s = DefaultParams.New(1)
+	for i, res := range referenceUint64 {
+		if r := s.Uint64(); r != res {
+			t.Errorf("Params.New().Uint64() [%v] = %v, want %v.", i, r, res)
+		}
+	}
+	if p := s.Params(); p != DefaultParams {
+		t.Errorf("Source.Params() = %v, want %v.", p, DefaultParams)
+	}
+}
+
+func TestSeedArray(t *testing.T) {
+	// the first double of init_by_array {1} in check64.out.txt (6 digits)
+	if r := fmt.Sprintf("%.6f", NewWithArray([]uint64{1}).Float64(mt.ClosedOpen)); r != "0.125567" {
+		t.Errorf("Source.Float64() = %v, want %v.", r, "0.125567")
+	}
+	// regression values
+	res := []uint64{2316304586286922237, 15094277089150361724, 5685675787316092711, 15229481068059623199, 4714098425347676722}
+	s := NewWithArray([]uint64{1})
+	for i, res := range res {
+		if r := s.Uint64(); r != res {
+			t.Errorf("Source.Uint64() [%v] = %v, want %v.", i, r, res)
+		}
+	}
+}
+
+func TestParams(t *testing.T) {
+	s1, s2 := New(1), Params{Mat1: DefaultParams.Mat1, Mat2: DefaultParams.Mat2, Tmat: 0}.New(1)
+	if r1, r2 := s1.Uint64(), s2.Uint64(); r1 == r2 {
+		t.Errorf("Source.Uint64() = %v with different parameter set, want different value.", r2)
+	}
+	if p := (Params{}).NewWithArray(nil).Params(); p != DefaultParams {
+		t.Errorf("Params.NewWithArray().Params() = %v, want %v.", p, DefaultParams)
+	}
+}
+
+func TestFloat64(t *testing.T) {
+	s1, s2 := New(1), New(1)
+	for i := 0; i < 100; i++ {
+		if f, res := s1.Float64(mt.ClosedOpen), float64(s2.Uint64()>>11)*(1.0/9007199254740992.0); f != res {
+			t.Errorf("Source.Float64() [%v] = %v, want %v.", i, f, res)
+		}
+		if f, res := s1.Real(1), mt.Closed.Float64(s2.Uint64()); f != res {
+			t.Errorf("Source.Real() [%v] = %v, want %v.", i, f, res)
+		}
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	s, res := &Source{}, New(5489) // a default initial seed is used
+	for i := 0; i < 10; i++ {
+		if r, want := s.Uint64(), res.Uint64(); r != want {
+			t.Errorf("<empty>.Uint64() [%v] = \"%v\", want \"%v\".", i, r, want)
+		}
+	}
+}
+
+func TestNil(t *testing.T) {
+	s := (*Source)(nil)
+	s.Seed(1)
+	s.SeedArray(nil)
+	if r := s.Uint64(); r != 0 {
+		t.Errorf("<nil>.Uint64() = \"%v\", want \"%v\".", r, 0)
+	}
+	if r := s.Real(0); r != 0 {
+		t.Errorf("<nil>.Real() = \"%v\", want \"%v\".", r, 0)
+	}
+	if p := s.Params(); p != (Params{}) {
+		t.Errorf("<nil>.Params() = \"%v\", want \"%v\".", p, Params{})
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2026 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */